@@ -2,6 +2,7 @@ package wordnik
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"net/url"
 )
@@ -38,6 +39,12 @@ type User struct {
 
 // AuthenticateGET returns an AuthenticationToken object for a given user.
 func (c *Client) AuthenticateGET(user, pass string) (AuthenticationToken, error) {
+	return c.AuthenticateGETContext(context.Background(), user, pass)
+}
+
+// AuthenticateGETContext is like AuthenticateGET, but carries a context for
+// cancellation and deadlines.
+func (c *Client) AuthenticateGETContext(ctx context.Context, user, pass string) (AuthenticationToken, error) {
 	if user == "" || pass == "" {
 		return AuthenticationToken{}, errors.New("empty username/password not allowed")
 	}
@@ -50,49 +57,69 @@ func (c *Client) AuthenticateGET(user, pass string) (AuthenticationToken, error)
 	}
 
 	var results AuthenticationToken
-	err := c.basicGetRequest(rel, q, &results)
+	err := c.basicGetRequest(ctx, "AuthenticateGET", rel, q, &results)
 
 	return results, err
 }
 
 // AuthenticatePOST returns an AuthenticationToken object for a given user.
 func (c *Client) AuthenticatePOST(user, pass string) (AuthenticationToken, error) {
+	return c.AuthenticatePOSTContext(context.Background(), user, pass)
+}
+
+// AuthenticatePOSTContext is like AuthenticatePOST, but carries a context for
+// cancellation and deadlines.
+func (c *Client) AuthenticatePOSTContext(ctx context.Context, user, pass string) (AuthenticationToken, error) {
 	if user == "" || pass == "" {
 		return AuthenticationToken{}, errors.New("empty username/password not allowed")
 	}
 
 	rel := &url.URL{Path: "account.json/authenticate/" + user}
 	body := bytes.NewBufferString(pass)
-	req, err := c.formRequest(rel, url.Values{}, "POST", body)
+	req, err := c.formRequest(ctx, rel, url.Values{}, "POST", body)
 	if err != nil {
 		return AuthenticationToken{}, err
 	}
 
 	var results AuthenticationToken
-	err = c.doRequest(req, &results)
+	err = c.doRequest("AuthenticatePOST", req, &results)
 
 	return results, err
 }
 
-// GetAPITokenStatus returns an APITokenStatus object for a given API key.
+// GetAPITokenStatus returns an APITokenStatus object for a given API key. The
+// request is not counted against the Client's RateLimiter.
 func (c *Client) GetAPITokenStatus() (APITokenStatus, error) {
+	return c.GetAPITokenStatusContext(context.Background())
+}
+
+// GetAPITokenStatusContext is like GetAPITokenStatus, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetAPITokenStatusContext(ctx context.Context) (APITokenStatus, error) {
+	ctx = withoutRateLimit(ctx)
 	rel := &url.URL{Path: "account.json/apiTokenStatus"}
 
 	var results APITokenStatus
-	err := c.basicGetRequest(rel, url.Values{}, &results)
+	err := c.basicGetRequest(ctx, "GetAPITokenStatus", rel, url.Values{}, &results)
 
 	return results, err
 }
 
 // GetUser returns a User object for a given authorization token.
 func (c *Client) GetUser(authToken string) (User, error) {
+	return c.GetUserContext(context.Background(), authToken)
+}
+
+// GetUserContext is like GetUser, but carries a context for cancellation and
+// deadlines.
+func (c *Client) GetUserContext(ctx context.Context, authToken string) (User, error) {
 	if authToken == "" {
 		return User{}, errors.New("empty auth token not allowed")
 	}
 
 	rel := &url.URL{Path: "account.json/user"}
 
-	req, err := c.formRequest(rel, url.Values{}, "GET")
+	req, err := c.formRequest(ctx, rel, url.Values{}, "GET")
 	if err != nil {
 		return User{}, err
 	}
@@ -100,13 +127,19 @@ func (c *Client) GetUser(authToken string) (User, error) {
 	req.Header["auth_token"] = []string{authToken}
 
 	var results User
-	err = c.doRequest(req, &results)
+	err = c.doRequest("GetUser", req, &results)
 
 	return results, err
 }
 
 // GetWordListsForUser returns a slice of WordList objects for a given account.
 func (c *Client) GetWordListsForUser(authToken string, options ...QueryOption) ([]WordList, error) {
+	return c.GetWordListsForUserContext(context.Background(), authToken, options...)
+}
+
+// GetWordListsForUserContext is like GetWordListsForUser, but carries a context
+// for cancellation and deadlines.
+func (c *Client) GetWordListsForUserContext(ctx context.Context, authToken string, options ...QueryOption) ([]WordList, error) {
 	if authToken == "" {
 		return []WordList{}, errors.New("empty auth token not allowed")
 	}
@@ -119,18 +152,48 @@ func (c *Client) GetWordListsForUser(authToken string, options ...QueryOption) (
 		"limit": []string{"50"},
 	}
 
-	for _, option := range options {
-		option(&q)
+	if err := c.applyOptions("GetWordListsForUser", &q, options); err != nil {
+		return []WordList{}, err
 	}
 
-	req, err := c.formRequest(rel, q, "GET")
+	req, err := c.formRequest(ctx, rel, q, "GET")
 	if err != nil {
 		return []WordList{}, err
 	}
 
 	req.Header["auth_token"] = []string{authToken}
 	var results []WordList
-	err = c.doRequest(req, &results)
+	err = c.doRequest("GetWordListsForUser", req, &results)
 
 	return results, err
 }
+
+// WordListsForUserIter returns a Pager over the WordLists for a given account,
+// which requests pages from GetWordListsForUser as needed. The Skip and Limit
+// options set the starting offset and page size.
+func (c *Client) WordListsForUserIter(authToken string, options ...QueryOption) *Pager[WordList] {
+	return c.WordListsForUserIterContext(context.Background(), authToken, options...)
+}
+
+// WordListsForUserIterContext is like WordListsForUserIter, but carries a
+// context for cancellation and deadlines.
+func (c *Client) WordListsForUserIterContext(ctx context.Context, authToken string, options ...QueryOption) *Pager[WordList] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]WordList, int64, error) {
+		lists, err := c.GetWordListsForUserContext(ctx, authToken, pageOptions(options, skip, limit)...)
+		return lists, 0, err
+	}
+
+	return newPager(ctx, fetch, false, options)
+}
+
+// AllWordListsForUser returns every WordList for a given account, requesting
+// as many pages as needed.
+func (c *Client) AllWordListsForUser(authToken string, options ...QueryOption) ([]WordList, error) {
+	return c.AllWordListsForUserContext(context.Background(), authToken, options...)
+}
+
+// AllWordListsForUserContext is like AllWordListsForUser, but carries a context
+// for cancellation and deadlines.
+func (c *Client) AllWordListsForUserContext(ctx context.Context, authToken string, options ...QueryOption) ([]WordList, error) {
+	return c.WordListsForUserIterContext(ctx, authToken, options...).Collect()
+}
@@ -47,7 +47,7 @@ func TestAuthenticatePOST(t *testing.T) {
 }
 
 // Helper function for testing which attempts to retrieve an AuthenticationToken
-// for username and password set by WORDNIK_TEST_USER and WORDNIK_TEST_PASS.
+// for the username and password set by WORDNIK_USERNAME and WORDNIK_PASSWORD.
 func (c *Client) getTestAuth(t *testing.T) (AuthenticationToken, error) {
 	tUser, err := getEnvUserPass()
 	if err != nil {
@@ -117,3 +117,27 @@ func TestGetWordListsForUser(t *testing.T) {
 		t.Error("expected at least one value in result")
 	}
 }
+
+func TestAllWordListsForUser(t *testing.T) {
+	cl, _, token := newListsClient(t, 120, 0)
+
+	lists, err := cl.AllWordListsForUser(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 120 {
+		t.Errorf("expected 120 lists, got %d", len(lists))
+	}
+
+	seen := map[string]bool{}
+	for _, list := range lists {
+		seen[list.Permalink] = true
+	}
+	if len(seen) != 120 {
+		t.Errorf("expected 120 distinct lists, got %d", len(seen))
+	}
+
+	if _, err := cl.AllWordListsForUser(""); err == nil {
+		t.Error("expected error for empty auth token")
+	}
+}
@@ -0,0 +1,243 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// LookupFacet selects the information BatchLookup fetches for each word.
+// Facets can be combined with |, e.g. LookupDefinitions|LookupAudio.
+type LookupFacet uint
+
+// Facets which BatchLookup can fetch, each with the Client method it calls.
+const (
+	LookupWord           LookupFacet = 1 << iota // GetWord
+	LookupDefinitions                            // GetDefinitions
+	LookupExamples                               // GetExamples
+	LookupTopExample                             // TopExample
+	LookupRelatedWords                           // GetRelatedWords
+	LookupPronunciations                         // Pronunciations
+	LookupHyphenation                            // Hyphenation
+	LookupFrequency                              // GetWordFrequency
+	LookupPhrases                                // GetPhrases
+	LookupEtymologies                            // GetEtymologies
+	LookupAudio                                  // GetAudio
+
+	// LookupAll selects every facet.
+	LookupAll = LookupAudio<<1 - 1
+)
+
+// facetNames names each facet after the Client method it calls.
+var facetNames = []string{
+	"GetWord", "GetDefinitions", "GetExamples", "TopExample", "GetRelatedWords",
+	"Pronunciations", "Hyphenation", "GetWordFrequency", "GetPhrases",
+	"GetEtymologies", "GetAudio",
+}
+
+// String returns the names of the Client methods called for f, separated by
+// "|".
+func (f LookupFacet) String() string {
+	var names []string
+	for i, name := range facetNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// defaultBatchConcurrency is the number of requests BatchLookup makes at once,
+// unless set by BatchConcurrency.
+const defaultBatchConcurrency = 4
+
+// LookupResult holds the information fetched by BatchLookup for one word.
+// Fields for facets which weren't requested, or which failed, are left zero.
+type LookupResult struct {
+	Word string
+
+	WordObject     WordObject
+	Definitions    []Definition
+	Examples       ExampleSearchResults
+	TopExample     Example
+	RelatedWords   []RelatedWord
+	Pronunciations []TextPron
+	Hyphenation    []Syllable
+	Frequency      FrequencySummary
+	Phrases        []Bigram
+	Etymologies    EtymologiesResponse
+	Audio          []AudioFile
+
+	// Errors holds the error for each facet which failed.
+	Errors map[LookupFacet]error
+}
+
+// Err returns the errors for every failed facet joined together, or nil if
+// all requested facets succeeded.
+func (r LookupResult) Err() error {
+	var errs []error
+	for i := range facetNames {
+		if err := r.Errors[1<<i]; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BatchOption configures a call to BatchLookup.
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	concurrency int
+	options     map[LookupFacet][]QueryOption
+}
+
+// BatchConcurrency sets the maximum number of requests BatchLookup makes at
+// once. The default is 4.
+func BatchConcurrency(n int) BatchOption {
+	return func(b *batchConfig) {
+		if n > 0 {
+			b.concurrency = n
+		}
+	}
+}
+
+// FacetOptions sets the QueryOptions used when fetching facet, which may
+// combine several facets, e.g. FacetOptions(LookupDefinitions, Limit(3)).
+func FacetOptions(facet LookupFacet, queryOptions ...QueryOption) BatchOption {
+	return func(b *batchConfig) {
+		for i := range facetNames {
+			if facet&(1<<i) != 0 {
+				b.options[1<<i] = append(b.options[1<<i], queryOptions...)
+			}
+		}
+	}
+}
+
+// BatchLookup fetches facets for each of words, making a bounded number of
+// requests at once. Each request goes through the Client as usual, so rate
+// limiting, retries and caching all apply. A failed request doesn't stop the
+// batch; its error is recorded in the word's LookupResult. Results are
+// returned in the same order as words.
+func (c *Client) BatchLookup(words []string, facets LookupFacet, options ...BatchOption) []LookupResult {
+	return c.BatchLookupContext(context.Background(), words, facets, options...)
+}
+
+// BatchLookupContext is like BatchLookup, but carries a context for
+// cancellation and deadlines. Once ctx is done, the remaining facets fail with
+// its error.
+func (c *Client) BatchLookupContext(ctx context.Context, words []string, facets LookupFacet, options ...BatchOption) []LookupResult {
+	config := batchConfig{concurrency: defaultBatchConcurrency, options: map[LookupFacet][]QueryOption{}}
+	for _, option := range options {
+		option(&config)
+	}
+
+	type task struct {
+		index int
+		facet LookupFacet
+	}
+
+	results := make([]LookupResult, len(words))
+	tasks := make(chan task)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < config.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tasks {
+				var err error
+				if err = ctx.Err(); err == nil {
+					err = c.lookupFacet(ctx, &results[t.index], &mu, t.facet, config.options[t.facet])
+				}
+				if err != nil {
+					mu.Lock()
+					if results[t.index].Errors == nil {
+						results[t.index].Errors = map[LookupFacet]error{}
+					}
+					results[t.index].Errors[t.facet] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i, word := range words {
+		results[i].Word = word
+		for f := range facetNames {
+			if facets&(1<<f) != 0 {
+				tasks <- task{i, 1 << f}
+			}
+		}
+	}
+	close(tasks)
+	wg.Wait()
+
+	return results
+}
+
+// lookupFacet fetches a single facet for result.Word, storing it in result
+// while holding mu.
+func (c *Client) lookupFacet(ctx context.Context, result *LookupResult, mu *sync.Mutex, facet LookupFacet, options []QueryOption) error {
+	word := result.Word
+
+	var store func()
+	var err error
+	switch facet {
+	case LookupWord:
+		var v WordObject
+		v, err = c.GetWordContext(ctx, word, options...)
+		store = func() { result.WordObject = v }
+	case LookupDefinitions:
+		var v []Definition
+		v, err = c.GetDefinitionsContext(ctx, word, options...)
+		store = func() { result.Definitions = v }
+	case LookupExamples:
+		var v ExampleSearchResults
+		v, err = c.GetExamplesContext(ctx, word, options...)
+		store = func() { result.Examples = v }
+	case LookupTopExample:
+		var v Example
+		v, err = c.TopExampleContext(ctx, word, options...)
+		store = func() { result.TopExample = v }
+	case LookupRelatedWords:
+		var v []RelatedWord
+		v, err = c.GetRelatedWordsContext(ctx, word, options...)
+		store = func() { result.RelatedWords = v }
+	case LookupPronunciations:
+		var v []TextPron
+		v, err = c.PronunciationsContext(ctx, word, options...)
+		store = func() { result.Pronunciations = v }
+	case LookupHyphenation:
+		var v []Syllable
+		v, err = c.HyphenationContext(ctx, word, options...)
+		store = func() { result.Hyphenation = v }
+	case LookupFrequency:
+		var v FrequencySummary
+		v, err = c.GetWordFrequencyContext(ctx, word, options...)
+		store = func() { result.Frequency = v }
+	case LookupPhrases:
+		var v []Bigram
+		v, err = c.GetPhrasesContext(ctx, word, options...)
+		store = func() { result.Phrases = v }
+	case LookupEtymologies:
+		var v EtymologiesResponse
+		v, err = c.GetEtymologiesContext(ctx, word, options...)
+		store = func() { result.Etymologies = v }
+	case LookupAudio:
+		var v []AudioFile
+		v, err = c.GetAudioContext(ctx, word, options...)
+		store = func() { result.Audio = v }
+	}
+
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	store()
+	mu.Unlock()
+	return nil
+}
@@ -0,0 +1,113 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
+)
+
+func TestBatchLookup(t *testing.T) {
+	srv := wordniktest.NewServer(wordniktest.DefaultFixtures())
+	t.Cleanup(srv.Close)
+
+	cl, err := NewClientWithOptions(wordniktest.DefaultAPIKey, WithBaseURL(srv.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	words := []string{"potato", "notaword", "tomato"}
+	results := cl.BatchLookup(words, LookupDefinitions|LookupPronunciations, FacetOptions(LookupDefinitions, Limit(2)))
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, result := range results {
+		if result.Word != words[i] {
+			t.Errorf("result %d is for %q, expected %q", i, result.Word, words[i])
+		}
+	}
+
+	if results[0].Err() != nil || len(results[0].Definitions) != 2 {
+		t.Errorf("expected 2 definitions for potato, got %d, err %v", len(results[0].Definitions), results[0].Err())
+	}
+	if results[2].Err() != nil || len(results[2].Pronunciations) == 0 {
+		t.Errorf("expected pronunciations for tomato, err %v", results[2].Err())
+	}
+	if results[0].Audio != nil {
+		t.Error("expected unrequested facets to be left empty")
+	}
+
+	if !IsNotFound(results[1].Errors[LookupDefinitions]) {
+		t.Errorf("expected not found error for notaword, got %v", results[1].Errors)
+	}
+	if len(results[1].Errors) != 2 || results[1].Err() == nil {
+		t.Errorf("expected both facets to fail for notaword, got %v", results[1].Errors)
+	}
+}
+
+func TestBatchConcurrency(t *testing.T) {
+	var inFlight, maxInFlight, calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		atomic.AddInt32(&calls, 1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		w.Write([]byte(`[]`))
+	})
+
+	words := make([]string, 20)
+	for i := range words {
+		words[i] = "cat" + strconv.Itoa(i)
+	}
+	cl.BatchLookup(words, LookupAudio|LookupHyphenation, BatchConcurrency(3))
+
+	if calls != 40 {
+		t.Errorf("expected 40 calls, got %d", calls)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 requests at once, got %d", maxInFlight)
+	}
+}
+
+func TestBatchLookupCancelled(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := cl.BatchLookupContext(ctx, []string{"cat", "dog"}, LookupAll)
+	for _, result := range results {
+		if len(result.Errors) != len(facetNames) {
+			t.Errorf("expected every facet to fail, got %d errors", len(result.Errors))
+		}
+		if !errors.Is(result.Err(), context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", result.Err())
+		}
+	}
+}
+
+func TestLookupFacetString(t *testing.T) {
+	facets := LookupDefinitions | LookupAudio
+	if facets.String() != "GetDefinitions|GetAudio" {
+		t.Errorf("got %q", facets.String())
+	}
+	if LookupAll.String() != "GetWord|GetDefinitions|GetExamples|TopExample|GetRelatedWords|Pronunciations|Hyphenation|GetWordFrequency|GetPhrases|GetEtymologies|GetAudio" {
+		t.Errorf("got %q for LookupAll", LookupAll.String())
+	}
+}
@@ -0,0 +1,270 @@
+package wordnik
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long responses are cached when a Cache is set without
+// an explicit TTL.
+const DefaultCacheTTL = 24 * time.Hour
+
+// cacheableEndpoints lists the endpoints whose responses are cached by default.
+// GetAudio is left out since the file URLs it returns expire.
+var cacheableEndpoints = map[string]bool{
+	"GetExamples":      true,
+	"GetWord":          true,
+	"GetDefinitions":   true,
+	"TopExample":       true,
+	"GetRelatedWords":  true,
+	"Pronunciations":   true,
+	"Hyphenation":      true,
+	"GetWordFrequency": true,
+	"GetPhrases":       true,
+	"GetEtymologies":   true,
+}
+
+// Cache stores raw API responses, keyed by endpoint path and encoded query.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the cached value for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key, expiring after ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// SetCache makes the Client cache responses from the word endpoints, such as
+// GetDefinitions and GetEtymologies, in cache for ttl. A ttl of zero uses
+// DefaultCacheTTL, and a nil cache disables caching. It should be called
+// before the Client is used concurrently.
+func (c *Client) SetCache(cache Cache, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	c.cache = cache
+	c.cacheTTL = ttl
+}
+
+// SetEndpointCacheTTL overrides the cache TTL for a single endpoint, named
+// after its Client method, e.g. "GetDefinitions". A ttl of zero disables
+// caching for the endpoint. Endpoints which aren't cached by default, such as
+// GetAudio, can be enabled this way. It should be called before the Client is
+// used concurrently.
+func (c *Client) SetEndpointCacheTTL(endpoint string, ttl time.Duration) {
+	if c.endpointTTLs == nil {
+		c.endpointTTLs = map[string]time.Duration{}
+	}
+
+	c.endpointTTLs[endpoint] = ttl
+}
+
+// endpointCacheTTL returns how long responses from endpoint are cached, or
+// zero if they aren't.
+func (c *Client) endpointCacheTTL(endpoint string) time.Duration {
+	if c.cache == nil {
+		return 0
+	}
+
+	if ttl, ok := c.endpointTTLs[endpoint]; ok {
+		return ttl
+	}
+
+	if cacheableEndpoints[endpoint] {
+		return c.cacheTTL
+	}
+
+	return 0
+}
+
+// cacheKey identifies a GET request by its path and query parameters.
+func cacheKey(rel *url.URL, vals url.Values) string {
+	return rel.Path + "?" + vals.Encode()
+}
+
+// warmEndpoints are the endpoints fetched by WarmCache when none are given.
+var warmEndpoints = []string{"GetDefinitions", "GetEtymologies", "Hyphenation", "Pronunciations"}
+
+// WarmCache fills the Client's Cache by fetching each of the given endpoints,
+// with default options, for every word. Endpoints are named after their Client
+// methods; if none are given, GetDefinitions, GetEtymologies, Hyphenation and
+// Pronunciations are fetched. Endpoints which the Client doesn't cache, such
+// as GetAudio unless enabled with SetEndpointCacheTTL, are rejected. Words
+// which aren't found are skipped, while any other error stops warming.
+func (c *Client) WarmCache(ctx context.Context, words []string, endpoints ...string) error {
+	if c.cache == nil {
+		return errors.New("no cache set")
+	}
+
+	if len(endpoints) == 0 {
+		endpoints = warmEndpoints
+	}
+
+	for _, endpoint := range endpoints {
+		if _, ok := warmers[endpoint]; !ok {
+			return errors.New("unknown or uncacheable endpoint: " + endpoint)
+		}
+		if c.endpointCacheTTL(endpoint) <= 0 {
+			return errors.New("endpoint not cached: " + endpoint)
+		}
+	}
+
+	for _, word := range words {
+		for _, endpoint := range endpoints {
+			err := warmers[endpoint](c, ctx, word)
+			if err != nil && !IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// warmers fetch a single word from each endpoint which can be cached,
+// discarding the result.
+var warmers = map[string]func(*Client, context.Context, string) error{
+	"GetExamples": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetExamplesContext(ctx, word)
+		return err
+	},
+	"GetWord": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetWordContext(ctx, word)
+		return err
+	},
+	"GetDefinitions": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetDefinitionsContext(ctx, word)
+		return err
+	},
+	"TopExample": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.TopExampleContext(ctx, word)
+		return err
+	},
+	"GetRelatedWords": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetRelatedWordsContext(ctx, word)
+		return err
+	},
+	"Pronunciations": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.PronunciationsContext(ctx, word)
+		return err
+	},
+	"Hyphenation": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.HyphenationContext(ctx, word)
+		return err
+	},
+	"GetWordFrequency": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetWordFrequencyContext(ctx, word)
+		return err
+	},
+	"GetPhrases": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetPhrasesContext(ctx, word)
+		return err
+	},
+	"GetEtymologies": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetEtymologiesContext(ctx, word)
+		return err
+	},
+	"GetAudio": func(c *Client, ctx context.Context, word string) error {
+		_, err := c.GetAudioContext(ctx, word)
+		return err
+	},
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context which makes requests skip reading from the
+// Client's Cache. Fresh responses are still written to it.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	return ctx.Value(bypassCacheKey{}) != nil
+}
+
+// MemoryCache is an in-memory Cache which evicts the least recently used
+// entry once full.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+	now        func() time.Time
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding at most maxEntries responses.
+// A maxEntries of zero means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*memoryCacheEntry)
+	if !m.now().Before(entry.expiresAt) {
+		m.remove(elem)
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expiresAt := m.now().Add(ttl)
+	if elem, ok := m.entries[key]; ok {
+		entry := elem.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key, value, expiresAt})
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// which haven't been evicted yet.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+// remove deletes elem from the cache. m.mu must be held.
+func (m *MemoryCache) remove(elem *list.Element) {
+	m.order.Remove(elem)
+	delete(m.entries, elem.Value.(*memoryCacheEntry).key)
+}
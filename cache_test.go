@@ -0,0 +1,112 @@
+package wordnik
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2017, 6, 12, 0, 0, 0, 0, time.UTC)
+	m := NewMemoryCache(2)
+	m.now = func() time.Time { return now }
+
+	m.Set("a", []byte("1"), time.Minute)
+	m.Set("b", []byte("2"), time.Hour)
+
+	if v, ok := m.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("expected hit for a, got %q %v", v, ok)
+	}
+
+	// "b" is now least recently used, and is evicted.
+	m.Set("c", []byte("3"), time.Hour)
+	if _, ok := m.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if m.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", m.Len())
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := m.Get("a"); ok {
+		t.Error("expected a to expire")
+	}
+	if _, ok := m.Get("c"); !ok {
+		t.Error("expected c to still be cached")
+	}
+}
+
+func TestClientCache(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`[{"text":"a feline"}]`))
+	})
+	cl.SetCache(NewMemoryCache(10), time.Hour)
+
+	for i := 0; i < 3; i++ {
+		res, err := cl.GetDefinitions("cat")
+		if err != nil || len(res) != 1 || res[0].Text != "a feline" {
+			t.Fatalf("unexpected result %v, %v", res, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call for repeated lookups, got %d", calls)
+	}
+
+	// Different query parameters make a different key.
+	cl.GetDefinitions("cat", Limit(1))
+	if calls != 2 {
+		t.Errorf("expected options to change the cache key, got %d calls", calls)
+	}
+
+	cl.GetDefinitionsContext(BypassCache(context.Background()), "cat")
+	if calls != 3 {
+		t.Errorf("expected BypassCache to skip the cache, got %d calls", calls)
+	}
+
+	// Random words must never come from the cache.
+	cl.RandomWord()
+	cl.RandomWord()
+	if calls != 5 {
+		t.Errorf("expected RandomWord not to be cached, got %d calls", calls)
+	}
+}
+
+func TestClientCacheEndpointTTL(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`[]`))
+	})
+	cl.SetCache(NewMemoryCache(10), 0)
+	cl.SetEndpointCacheTTL("GetDefinitions", 0)
+	cl.SetEndpointCacheTTL("GetAudio", time.Minute)
+
+	if cl.cacheTTL != DefaultCacheTTL {
+		t.Errorf("expected default TTL, got %v", cl.cacheTTL)
+	}
+
+	cl.GetDefinitions("cat")
+	cl.GetDefinitions("cat")
+	cl.GetAudio("cat")
+	cl.GetAudio("cat")
+	if calls != 3 {
+		t.Errorf("expected overrides to disable and enable caching, got %d calls", calls)
+	}
+}
+
+func TestClientCacheSkipsErrors(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 1, http.StatusInternalServerError, `{"word":"cat"}`))
+	cl.SetCache(NewMemoryCache(10), time.Hour)
+
+	if _, err := cl.GetWord("cat"); err == nil {
+		t.Error("expected error from first call")
+	}
+	if res, err := cl.GetWord("cat"); err != nil || res.Word != "cat" {
+		t.Errorf("expected error not to be cached, got %v, %v", res, err)
+	}
+}
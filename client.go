@@ -1,44 +1,67 @@
 package wordnik
 
 import (
+	"context"
 	"encoding/json"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
 const (
-	base = "https://api.wordnik.com/v4/"
+	base           = "https://api.wordnik.com/v4/"
+	defaultTimeout = 10 * time.Second
 )
 
 // Client is an http.Client wrapper which stores an API key and base url.
 type Client struct {
-	apiKey  string
-	baseURL *url.URL
-	client  *http.Client
+	apiKey      string
+	baseURL     *url.URL
+	client      *http.Client
+	timeout     time.Duration
+	hasTimeout  bool
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
+	keyPool     *KeyPool
+	userAgent   string
+	middleware  []Middleware
+	logger      *slog.Logger
+
+	strict       bool
+	optionPolicy OptionPolicy
+
+	instrumentation Instrumentation
+
+	cache        Cache
+	cacheTTL     time.Duration
+	endpointTTLs map[string]time.Duration
+
+	flights flightGroup
 }
 
 // NewClient creates a Client with the specified API key. The http.Client
-// component is configured with a 10-second timeout.
+// component is configured with a 10-second timeout, unless a custom client is
+// given. See NewClientWithOptions for further configuration.
 func NewClient(key string, customClients ...*http.Client) *Client {
-	baseURL, err := url.Parse(base)
-	if err != nil {
-		panic(err)
+	var options []ClientOption
+	if len(customClients) > 0 && customClients[0] != nil {
+		options = append(options, WithHTTPClient(customClients[0]))
 	}
 
-	var httpClient *http.Client
-
-	if len(customClients) > 0 && customClients[0] != nil {
-		httpClient = customClients[0]
-	} else {
-		httpClient = &http.Client{Timeout: time.Second * 10}
+	c, err := NewClientWithOptions(key, options...)
+	if err != nil {
+		panic(err)
 	}
 
-	return &Client{key, baseURL, httpClient}
+	return c
 }
 
-func (c *Client) formRequest(relativePath *url.URL, vals url.Values, method string, reader ...io.Reader) (*http.Request, error) {
+// formRequest builds a request for the given path relative to the base url.
+// The request is bound to ctx, so cancelling ctx aborts it.
+func (c *Client) formRequest(ctx context.Context, relativePath *url.URL, vals url.Values, method string, reader ...io.Reader) (*http.Request, error) {
 	u := c.baseURL.ResolveReference(relativePath)
 	u.RawQuery = vals.Encode()
 
@@ -47,43 +70,147 @@ func (c *Client) formRequest(relativePath *url.URL, vals url.Values, method stri
 		body = reader[0]
 	}
 
-	request, err := http.NewRequest(method, u.String(), body)
+	request, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return request, err
 	}
 
 	request.Header["api_key"] = []string{c.apiKey}
 	request.Header["Content-type"] = []string{"application/json"}
+	if c.userAgent != "" {
+		request.Header.Set("User-Agent", c.userAgent)
+	}
 	return request, nil
 }
 
-func (c *Client) doRequest(req *http.Request, dst interface{}) error {
-	res, err := c.client.Do(req)
+// response is the result of a successful fetch.
+type response struct {
+	body       []byte
+	statusCode int
+	attempts   int
+}
+
+// doRequest sends req and decodes the JSON response into dst unless dst is nil.
+// The request is reported to the Client's Instrumentation as a call to
+// endpoint.
+func (c *Client) doRequest(endpoint string, req *http.Request, dst interface{}) error {
+	ctx, endCall := c.startCall(req.Context(), endpoint, req.Method, c.relativePath(req.URL))
+	req = req.WithContext(ctx)
+
+	res, err := c.fetch(req)
+	if err == nil && dst != nil {
+		err = json.Unmarshal(res.body, dst)
+	}
+
+	endCall(res, false, err)
+	return err
+}
+
+// fetch sends req, retrying according to the Client's RetryPolicy, and returns
+// the response.
+func (c *Client) fetch(req *http.Request) (response, error) {
+	attempts := c.retryPolicy.attemptsFor(req)
+
+	for attempt := 1; ; attempt++ {
+		body, statusCode, err := c.sendWithKeyPool(req)
+		if err == nil || attempt >= attempts || !c.retryPolicy.shouldRetry(err) {
+			return response{body, statusCode, attempt}, err
+		}
+
+		delay, ok := c.retryPolicy.delay(attempt, err)
+		if !ok {
+			return response{body, statusCode, attempt}, err
+		}
+
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return response{attempts: attempt}, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return response{attempts: attempt}, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// sendRequest sends req once, taking a call from the Client's RateLimiter
+// first, and returns the response body and status code.
+func (c *Client) sendRequest(req *http.Request) ([]byte, int, error) {
+	if err := c.waitRateLimit(req.Context()); err != nil {
+		return nil, 0, err
+	}
+
+	res, err := c.doer().Do(req)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
 
 	defer res.Body.Close()
-	if dst == nil {
-		return nil
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		apiErr := c.newAPIError(req, res)
+		if apiErr.StatusCode == http.StatusTooManyRequests && c.rateLimiter != nil && c.keyPool == nil {
+			c.rateLimiter.exhaust(apiErr.RetryAfter)
+		}
+		return nil, res.StatusCode, apiErr
 	}
 
-	return json.NewDecoder(res.Body).Decode(dst)
+	body, err := io.ReadAll(res.Body)
+	return body, res.StatusCode, err
 }
 
 // basicGetRequest is a helper method which makes most of the GET requests
-// endpoints simpler.
-func (c *Client) basicGetRequest(rel *url.URL, vals url.Values, dst interface{}, options ...QueryOption) error {
-	for _, option := range options {
-		option(&vals)
+// endpoints simpler. Responses from cacheable endpoints are served from and
+// stored in the Client's Cache, and identical concurrent requests share a
+// single response.
+func (c *Client) basicGetRequest(ctx context.Context, endpoint string, rel *url.URL, vals url.Values, dst interface{}, options ...QueryOption) error {
+	if err := c.applyOptions(endpoint, &vals, options); err != nil {
+		return err
 	}
 
-	req, err := c.formRequest(rel, vals, "GET")
-	if err != nil {
-		return err
+	ctx, endCall := c.startCall(ctx, endpoint, "GET", rel.Path)
+
+	ttl := c.endpointCacheTTL(endpoint)
+	key := cacheKey(rel, vals)
+	if ttl > 0 && !cacheBypassed(ctx) {
+		if body, ok := c.cache.Get(key); ok {
+			err := json.Unmarshal(body, dst)
+			endCall(response{}, true, err)
+			return err
+		}
+	}
+
+	fetch := func(ctx context.Context) (response, error) {
+		req, err := c.formRequest(ctx, rel, vals, "GET")
+		if err != nil {
+			return response{}, err
+		}
+
+		res, err := c.fetch(req)
+		if err == nil && ttl > 0 {
+			c.cache.Set(key, res.body, ttl)
+		}
+		return res, err
 	}
 
-	err = c.doRequest(req, dst)
+	var res response
+	var err error
+	if coalesces(ctx, endpoint) {
+		res, err = c.flights.do(ctx, key, fetch)
+	} else {
+		res, err = fetch(ctx)
+	}
+	if err == nil {
+		err = json.Unmarshal(res.body, dst)
+	}
 
+	endCall(res, false, err)
 	return err
 }
+
+// relativePath returns the path of u relative to the Client's base url.
+func (c *Client) relativePath(u *url.URL) string {
+	return strings.TrimPrefix(u.Path, c.baseURL.Path)
+}
@@ -0,0 +1,193 @@
+package wordnik
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ClientOption functions configure a Client created by NewClientWithOptions.
+// Options are applied in order.
+type ClientOption func(*Client) error
+
+// NewClientWithOptions creates a Client with the specified API key, configured
+// by the given options. Without options it is equivalent to NewClient(key).
+func NewClientWithOptions(key string, options ...ClientOption) (*Client, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		apiKey:  key,
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: defaultTimeout},
+	}
+
+	for _, option := range options {
+		if err := option(c); err != nil {
+			return nil, err
+		}
+	}
+
+	// The timeout is applied last so that it holds whichever http.Client the
+	// options settled on.
+	if c.hasTimeout {
+		httpClient := *c.client
+		httpClient.Timeout = c.timeout
+		c.client = &httpClient
+	}
+
+	return c, nil
+}
+
+// WithBaseURL points the Client at a different API root, such as a staging
+// proxy or a local mock server. Endpoint paths are resolved relative to it, so
+// "http://localhost:8080/v4" results in requests like
+// "http://localhost:8080/v4/word.json/cat".
+func WithBaseURL(rawURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return err
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return errors.New("base url must be absolute: " + rawURL)
+		}
+
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithHTTPClient makes the Client send requests through httpClient instead of
+// its default http.Client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("nil http.Client not allowed")
+		}
+
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of the Client's http.Client. It applies after
+// all other options, so it also holds for an http.Client given to
+// WithHTTPClient, which is copied rather than modified.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.timeout = d
+		c.hasTimeout = true
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the Client's RetryPolicy. See SetRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithKeyPool makes the Client choose an API key from p for every request.
+// See SetKeyPool. It fails if p is nil or has no keys.
+func WithKeyPool(p *KeyPool) ClientOption {
+	return func(c *Client) error {
+		if p == nil {
+			return errors.New("nil key pool not allowed")
+		}
+		if len(p.Keys()) == 0 {
+			return errors.New("key pool has no keys")
+		}
+
+		c.SetKeyPool(p)
+		return nil
+	}
+}
+
+// WithRateLimiter sets the Client's RateLimiter. See SetRateLimiter.
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.rateLimiter = l
+		return nil
+	}
+}
+
+// WithCache sets the Client's response Cache. See SetCache.
+func WithCache(cache Cache, ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.SetCache(cache, ttl)
+		return nil
+	}
+}
+
+// WithEndpointCacheTTL overrides the cache TTL of a single endpoint. See
+// SetEndpointCacheTTL.
+func WithEndpointCacheTTL(endpoint string, ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.SetEndpointCacheTTL(endpoint, ttl)
+		return nil
+	}
+}
+
+// WithMiddleware appends middleware to the Client's chain. See Use.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) error {
+		c.Use(middleware...)
+		return nil
+	}
+}
+
+// WithLogger sets the Client's logger. See SetLogger.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		c.SetLogger(logger)
+		return nil
+	}
+}
+
+// WithInstrumentation sets the Client's Instrumentation. See
+// SetInstrumentation.
+func WithInstrumentation(inst Instrumentation) ClientOption {
+	return func(c *Client) error {
+		c.SetInstrumentation(inst)
+		return nil
+	}
+}
+
+// WithStrictValidation makes the Client reject invalid query options. See
+// SetStrictValidation.
+func WithStrictValidation() ClientOption {
+	return func(c *Client) error {
+		c.SetStrictValidation(true)
+		return nil
+	}
+}
+
+// WithOptionPolicy sets how the Client treats query options which don't apply
+// to an endpoint. See SetOptionPolicy.
+func WithOptionPolicy(policy OptionPolicy) ClientOption {
+	return func(c *Client) error {
+		c.SetOptionPolicy(policy)
+		return nil
+	}
+}
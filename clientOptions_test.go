@@ -0,0 +1,86 @@
+package wordnik
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	custom := &http.Client{Timeout: time.Minute}
+	limiter := NewRateLimiter(10, time.Hour)
+
+	cl, err := NewClientWithOptions("abc",
+		WithBaseURL("http://localhost:8080/v4"),
+		WithHTTPClient(custom),
+		WithTimeout(time.Second),
+		WithUserAgent("test-agent"),
+		WithRetryPolicy(DefaultRetryPolicy()),
+		WithRateLimiter(limiter),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cl.baseURL.String() != "http://localhost:8080/v4/" {
+		t.Errorf("got base url %q", cl.baseURL)
+	}
+	if cl.client.Timeout != time.Second || custom.Timeout != time.Minute {
+		t.Error("expected WithTimeout to apply to a copy of the custom http.Client")
+	}
+	if cl.retryPolicy.MaxAttempts != 3 || cl.rateLimiter != limiter {
+		t.Error("expected retry policy and rate limiter to be set")
+	}
+}
+
+func TestWithTimeoutBeforeHTTPClient(t *testing.T) {
+	custom := &http.Client{Timeout: time.Minute}
+
+	cl, err := NewClientWithOptions("abc", WithTimeout(time.Second), WithHTTPClient(custom))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cl.client.Timeout != time.Second || custom.Timeout != time.Minute {
+		t.Errorf("expected WithTimeout to apply regardless of order, got %v", cl.client.Timeout)
+	}
+}
+
+func TestNewClientWithOptionsErrors(t *testing.T) {
+	badOptions := []ClientOption{
+		WithBaseURL("localhost"),
+		WithBaseURL("http://[::1"),
+		WithHTTPClient(nil),
+	}
+
+	for _, option := range badOptions {
+		if _, err := NewClientWithOptions("abc", option); err == nil {
+			t.Error("expected error for invalid option")
+		}
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	var userAgent string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+		w.Write([]byte(`{}`))
+	})
+	WithUserAgent("go-wordnik-test")(cl)
+
+	cl.GetWord("cat")
+	if userAgent != "go-wordnik-test" {
+		t.Errorf("got User-Agent %q", userAgent)
+	}
+}
+
+func TestWithStrictValidation(t *testing.T) {
+	cl, err := NewClientWithOptions("abc", WithStrictValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cl.GetWordListWords("token", "list", SortOrder("sideways")); err == nil {
+		t.Error("expected validation error")
+	}
+}
@@ -1,8 +1,14 @@
 package wordnik
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
 )
 
 func TestNewClient(t *testing.T) {
@@ -19,8 +25,49 @@ func TestNewClient(t *testing.T) {
 
 func TestFormRequest(t *testing.T) {
 	cl := NewClient("abc")
-	_, err := cl.formRequest(cl.baseURL, url.Values{}, "bad method")
+	_, err := cl.formRequest(context.Background(), cl.baseURL, url.Values{}, "bad method")
 	if err == nil {
 		t.Error("Expected error for invalid method")
 	}
 }
+
+// Helper function for testing which returns a Client pointed at a local test
+// server using the given handler. The server is closed when the test ends.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cl, err := NewClientWithOptions("abc", WithBaseURL(srv.URL+"/v4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cl
+}
+
+// Helper function for testing which returns a Client with the given key and
+// options, pointed at a wordniktest.Server seeded with fixtures. The server is
+// closed when the test ends.
+func newFixtureClient(t *testing.T, fixtures wordniktest.Fixtures, key string, options ...ClientOption) (*Client, *wordniktest.Server) {
+	srv := wordniktest.NewServer(fixtures)
+	t.Cleanup(srv.Close)
+
+	cl, err := NewClientWithOptions(key, append([]ClientOption{WithBaseURL(srv.BaseURL())}, options...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cl, srv
+}
+
+func TestRequestContextCancelled(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := cl.GetWordContext(ctx, "cat")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package wordnik
+
+import (
+	"context"
+	"sync"
+)
+
+// uncoalescedEndpoints lists the GET endpoints whose concurrent identical
+// requests must not share a response, since each call should differ.
+var uncoalescedEndpoints = map[string]bool{
+	"RandomWord":  true,
+	"RandomWords": true,
+}
+
+// flightGroup deduplicates concurrent fetches with the same key, so that only
+// the first caller makes the request and the rest share its result.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done chan struct{}
+	res  response
+	err  error
+
+	// panicValue holds the value fn panicked with, if it did.
+	panicValue interface{}
+
+	// waiters counts the callers still waiting for the flight, and cancel
+	// stops it once there are none. Both are guarded by flightGroup.mu.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do calls fn unless a call with the same key is already in flight, in which
+// case it shares that call's result. The call runs in its own goroutine with a
+// context that keeps ctx's values but not its cancellation, so that no caller
+// giving up fails the others; each caller stops waiting when its own ctx is
+// done, and once every caller has stopped waiting the call is cancelled. If fn
+// panics, the callers waiting for it panic with the same value.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) (response, error)) (response, error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = map[string]*flight{}
+	}
+
+	f, ok := g.flights[key]
+	if !ok {
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+		go g.run(flightCtx, key, f, fn)
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		if f.panicValue != nil {
+			panic(f.panicValue)
+		}
+		return f.res, f.err
+	case <-ctx.Done():
+		g.leave(key, f)
+		return response{}, ctx.Err()
+	}
+}
+
+// leave records that a caller stopped waiting for f. The last one to leave
+// cancels f, and removes it from g so that later callers start afresh rather
+// than sharing the cancelled call.
+func (g *flightGroup) leave(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	f.waiters--
+	if f.waiters == 0 {
+		f.cancel()
+		if g.flights[key] == f {
+			delete(g.flights, key)
+		}
+	}
+}
+
+// run calls fn for the flight f, then removes it from g and releases its
+// callers, even if fn panics.
+func (g *flightGroup) run(ctx context.Context, key string, f *flight, fn func(context.Context) (response, error)) {
+	defer func() {
+		f.panicValue = recover()
+
+		g.mu.Lock()
+		if g.flights[key] == f {
+			delete(g.flights, key)
+		}
+		g.mu.Unlock()
+
+		f.cancel()
+		close(f.done)
+	}()
+
+	f.res, f.err = fn(ctx)
+}
+
+type bypassCoalescingKey struct{}
+
+// BypassCoalescing returns a context which makes requests go out on their own,
+// rather than sharing the response of an identical request already in flight.
+func BypassCoalescing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCoalescingKey{}, true)
+}
+
+// coalesces reports whether a GET request to endpoint made with ctx may share
+// its response with identical concurrent requests.
+func coalesces(ctx context.Context, endpoint string) bool {
+	return !uncoalescedEndpoints[endpoint] && ctx.Value(bypassCoalescingKey{}) == nil
+}
@@ -0,0 +1,220 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Helper function for testing which returns the number of callers waiting for
+// flights in g.
+func flightWaiters(g *flightGroup) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	waiters := 0
+	for _, f := range g.flights {
+		waiters += f.waiters
+	}
+	return waiters
+}
+
+// Helper function for testing which polls until cond holds, failing the test
+// if it doesn't within a few seconds.
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Helper function for testing which starts n concurrent calls of f against a
+// server that holds every request until all calls have reached it, or all have
+// joined the same call in flight. It returns how many requests reached the server.
+func concurrentCalls(t *testing.T, n int, f func(cl *Client) error) int32 {
+	var calls int32
+	release := make(chan struct{})
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		w.Write([]byte(`[{"text":"luck"}]`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := f(cl); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	waitUntil(t, func() bool {
+		return int(atomic.LoadInt32(&calls)) == n || flightWaiters(&cl.flights) == n
+	})
+	close(release)
+	wg.Wait()
+
+	return atomic.LoadInt32(&calls)
+}
+
+func TestCoalescing(t *testing.T) {
+	calls := concurrentCalls(t, 5, func(cl *Client) error {
+		res, err := cl.GetDefinitions("serendipity")
+		if err == nil && (len(res) != 1 || res[0].Text != "luck") {
+			err = errors.New("unexpected shared result")
+		}
+		return err
+	})
+	if calls != 1 {
+		t.Errorf("expected concurrent identical requests to share 1 call, got %d", calls)
+	}
+}
+
+func TestCoalescingOptOut(t *testing.T) {
+	calls := concurrentCalls(t, 3, func(cl *Client) error {
+		_, err := cl.GetDefinitionsContext(BypassCoalescing(context.Background()), "serendipity")
+		return err
+	})
+	if calls != 3 {
+		t.Errorf("expected BypassCoalescing to make separate calls, got %d", calls)
+	}
+
+	calls = concurrentCalls(t, 3, func(cl *Client) error {
+		_, err := cl.RandomWords()
+		return err
+	})
+	if calls != 3 {
+		t.Errorf("expected RandomWords not to be coalesced, got %d", calls)
+	}
+}
+
+func TestCoalescingWaiterContext(t *testing.T) {
+	var g flightGroup
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go g.do(context.Background(), "key", func(context.Context) (response, error) {
+		close(started)
+		<-release
+		return response{}, nil
+	})
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := g.do(ctx, "key", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected waiting caller to respect its context, got %v", err)
+	}
+	close(release)
+}
+
+func TestCoalescingFirstCallerCancelled(t *testing.T) {
+	var g flightGroup
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := g.do(ctx, "key", func(ctx context.Context) (response, error) {
+			close(started)
+			<-release
+			return response{body: []byte("shared")}, ctx.Err()
+		})
+		first <- err
+	}()
+	<-started
+
+	second := make(chan response)
+	go func() {
+		res, err := g.do(context.Background(), "key", func(context.Context) (response, error) {
+			return response{}, errors.New("expected to share the call in flight")
+		})
+		if err != nil {
+			t.Errorf("expected waiter to be unaffected by the first caller, got %v", err)
+		}
+		second <- res
+	}()
+
+	waitUntil(t, func() bool { return flightWaiters(&g) == 2 })
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected first caller to stop waiting, got %v", err)
+	}
+
+	close(release)
+	if res := <-second; string(res.body) != "shared" {
+		t.Errorf("expected shared result, got %q", res.body)
+	}
+}
+
+func TestCoalescingPanic(t *testing.T) {
+	var g flightGroup
+
+	func() {
+		defer func() {
+			if v := recover(); v != "boom" {
+				t.Errorf("expected panic to reach the caller, got %v", v)
+			}
+		}()
+		g.do(context.Background(), "key", func(context.Context) (response, error) {
+			panic("boom")
+		})
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		g.do(context.Background(), "key", func(context.Context) (response, error) {
+			return response{}, nil
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected later call not to hang after a panic")
+	}
+}
+
+func TestCoalescingAllCallersCancelled(t *testing.T) {
+	var g flightGroup
+	started := make(chan struct{})
+	ended := make(chan error)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go g.do(ctx, "key", func(ctx context.Context) (response, error) {
+		close(started)
+		<-ctx.Done()
+		ended <- ctx.Err()
+		return response{}, ctx.Err()
+	})
+	<-started
+
+	cancel()
+	select {
+	case err := <-ended:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected flight to be cancelled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected flight to end once every caller stopped waiting")
+	}
+
+	res, err := g.do(context.Background(), "key", func(context.Context) (response, error) {
+		return response{body: []byte("fresh")}, nil
+	})
+	if err != nil || string(res.body) != "fresh" {
+		t.Errorf("expected a later call to start afresh, got %q, %v", res.body, err)
+	}
+}
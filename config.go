@@ -0,0 +1,222 @@
+package wordnik
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Environment variables read by LoadConfig.
+const (
+	EnvAPIKey   = "WORDNIK_API_KEY"
+	EnvBaseURL  = "WORDNIK_BASE_URL"
+	EnvTimeout  = "WORDNIK_TIMEOUT"
+	EnvUsername = "WORDNIK_USERNAME"
+	EnvPassword = "WORDNIK_PASSWORD"
+
+	// EnvConfig names a config file to read instead of the default one.
+	EnvConfig = "WORDNIK_CONFIG"
+)
+
+// ErrNoAPIKey is returned when a Client is created from a Config without an
+// API key.
+var ErrNoAPIKey = errors.New("wordnik: no API key configured")
+
+// ErrNoCredentials is returned when a Session is created from a Config without
+// a username and password.
+var ErrNoCredentials = errors.New("wordnik: no username and password configured")
+
+// Config holds the settings needed to create a Client, and optionally a
+// Session, as loaded by LoadConfig. Empty fields are left at their defaults.
+type Config struct {
+	APIKey   string
+	BaseURL  string
+	Timeout  time.Duration
+	Username string
+	Password string
+
+	// Path is the config file the settings were read from, if any.
+	Path string
+}
+
+// configFile is the JSON form of a config file, e.g.
+//
+//	{"apiKey": "...", "baseURL": "...", "timeout": "5s", "username": "...", "password": "..."}
+type configFile struct {
+	APIKey   string `json:"apiKey"`
+	BaseURL  string `json:"baseURL"`
+	Timeout  string `json:"timeout"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// DefaultConfigPath returns the config file read by LoadConfig when
+// WORDNIK_CONFIG isn't set: wordnik/config.json in the user's config
+// directory, e.g. ~/.config/wordnik/config.json on Linux.
+func DefaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "wordnik", "config.json"), nil
+}
+
+// LoadConfig loads settings from a config file, then overrides them with any
+// set in the environment, so environment variables take precedence. The file
+// is the one named by WORDNIK_CONFIG, which must exist, or else the one at
+// DefaultConfigPath, which is skipped if it doesn't exist. The environment
+// variables are WORDNIK_API_KEY, WORDNIK_BASE_URL, WORDNIK_TIMEOUT (e.g. "5s"),
+// WORDNIK_USERNAME and WORDNIK_PASSWORD.
+func LoadConfig() (Config, error) {
+	var cfg Config
+
+	path := os.Getenv(EnvConfig)
+	required := path != ""
+	if !required {
+		if defaultPath, err := DefaultConfigPath(); err == nil {
+			path = defaultPath
+		}
+	}
+
+	if path != "" {
+		fileCfg, err := LoadConfigFile(path)
+		switch {
+		case err == nil:
+			cfg = fileCfg
+		case !required && errors.Is(err, fs.ErrNotExist):
+		default:
+			return Config{}, err
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// LoadConfigFile loads settings from the JSON config file at path, ignoring
+// the environment.
+func LoadConfigFile(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var file configFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return Config{}, fmt.Errorf("wordnik: invalid config file %s: %w", path, err)
+	}
+
+	cfg := Config{
+		APIKey:   file.APIKey,
+		BaseURL:  file.BaseURL,
+		Username: file.Username,
+		Password: file.Password,
+		Path:     path,
+	}
+
+	if file.Timeout != "" {
+		cfg.Timeout, err = time.ParseDuration(file.Timeout)
+		if err != nil {
+			return Config{}, fmt.Errorf("wordnik: invalid timeout %q in config file %s", file.Timeout, path)
+		}
+		if cfg.Timeout <= 0 {
+			return Config{}, fmt.Errorf("wordnik: timeout %q in config file %s must be positive", file.Timeout, path)
+		}
+	}
+
+	return cfg, nil
+}
+
+// applyEnv overrides cfg with any settings in the environment.
+func (cfg *Config) applyEnv() error {
+	for env, field := range map[string]*string{
+		EnvAPIKey:   &cfg.APIKey,
+		EnvBaseURL:  &cfg.BaseURL,
+		EnvUsername: &cfg.Username,
+		EnvPassword: &cfg.Password,
+	} {
+		if value := os.Getenv(env); value != "" {
+			*field = value
+		}
+	}
+
+	if value := os.Getenv(EnvTimeout); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("wordnik: invalid %s %q", EnvTimeout, value)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("wordnik: %s %q must be positive", EnvTimeout, value)
+		}
+		cfg.Timeout = timeout
+	}
+
+	return nil
+}
+
+// file names the config file settings could be added to, for error messages.
+func (cfg Config) file() string {
+	if cfg.Path != "" {
+		return cfg.Path
+	}
+	if path, err := DefaultConfigPath(); err == nil {
+		return path
+	}
+	return "a config file"
+}
+
+// ClientOptions returns the ClientOptions for cfg's base URL and timeout, if
+// set.
+func (cfg Config) ClientOptions() []ClientOption {
+	var options []ClientOption
+	if cfg.BaseURL != "" {
+		options = append(options, WithBaseURL(cfg.BaseURL))
+	}
+	if cfg.Timeout > 0 {
+		options = append(options, WithTimeout(cfg.Timeout))
+	}
+	return options
+}
+
+// NewClient creates a Client from cfg, followed by any further options, which
+// take precedence. cfg's timeout also applies to an http.Client given with
+// WithHTTPClient, unless options include WithTimeout. It returns an error
+// wrapping ErrNoAPIKey if cfg has no API key and options don't include a
+// KeyPool.
+func (cfg Config) NewClient(options ...ClientOption) (*Client, error) {
+	c, err := NewClientWithOptions(cfg.APIKey, append(cfg.ClientOptions(), options...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.apiKey == "" && c.keyPool == nil {
+		return nil, fmt.Errorf("%w: set %s, or \"apiKey\" in %s", ErrNoAPIKey, EnvAPIKey, cfg.file())
+	}
+	return c, nil
+}
+
+// NewSession creates a Session for cfg's username and password, making calls
+// with c. It returns an error wrapping ErrNoCredentials if either is missing.
+func (cfg Config) NewSession(c *Client) (*Session, error) {
+	if cfg.Username == "" || cfg.Password == "" {
+		return nil, fmt.Errorf("%w: set %s and %s, or \"username\" and \"password\" in %s",
+			ErrNoCredentials, EnvUsername, EnvPassword, cfg.file())
+	}
+	return NewSession(c, cfg.Username, cfg.Password), nil
+}
+
+// NewClientFromEnv creates a Client configured by LoadConfig, followed by any
+// further options, which take precedence.
+func NewClientFromEnv(options ...ClientOption) (*Client, error) {
+	cfg, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewClient(options...)
+}
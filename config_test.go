@@ -0,0 +1,165 @@
+package wordnik
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Helper function for testing which clears the configuration environment
+// variables and points the default config path at an empty directory, which
+// is returned.
+func clearConfigEnv(t *testing.T) string {
+	for _, env := range []string{EnvAPIKey, EnvBaseURL, EnvTimeout, EnvUsername, EnvPassword, EnvConfig} {
+		t.Setenv(env, "")
+	}
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	return dir
+}
+
+// Helper function for testing which writes a config file to path.
+func writeConfig(t *testing.T, path, contents string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	clearConfigEnv(t)
+
+	path, err := DefaultConfigPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeConfig(t, path, `{"apiKey": "file-key", "baseURL": "http://file.example/v4", "timeout": "3s", "username": "file-user", "password": "file-pass"}`)
+
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvTimeout, "7s")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{
+		APIKey:   "env-key",
+		BaseURL:  "http://file.example/v4",
+		Timeout:  7 * time.Second,
+		Username: "file-user",
+		Password: "file-pass",
+		Path:     path,
+	}
+	if cfg != expected {
+		t.Errorf("got %+v, expected %+v", cfg, expected)
+	}
+}
+
+func TestLoadConfigExplicitPath(t *testing.T) {
+	dir := clearConfigEnv(t)
+
+	path := filepath.Join(dir, "custom.json")
+	t.Setenv(EnvConfig, path)
+
+	if _, err := LoadConfig(); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected missing WORDNIK_CONFIG file to be an error, got %v", err)
+	}
+
+	writeConfig(t, path, `{"apiKey": "custom-key"}`)
+	cfg, err := LoadConfig()
+	if err != nil || cfg.APIKey != "custom-key" {
+		t.Errorf("got %+v, %v", cfg, err)
+	}
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	dir := clearConfigEnv(t)
+
+	path := filepath.Join(dir, "bad.json")
+	writeConfig(t, path, `{"apiKey": `)
+	if _, err := LoadConfigFile(path); err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("expected error naming the file, got %v", err)
+	}
+
+	writeConfig(t, path, `{"timeout": "soon"}`)
+	if _, err := LoadConfigFile(path); err == nil {
+		t.Error("expected error for invalid timeout in file")
+	}
+
+	for _, timeout := range []string{"0s", "-5s"} {
+		writeConfig(t, path, `{"timeout": "`+timeout+`"}`)
+		if _, err := LoadConfigFile(path); err == nil || !strings.Contains(err.Error(), "positive") {
+			t.Errorf("expected error for timeout %q in file, got %v", timeout, err)
+		}
+	}
+
+	for _, timeout := range []string{"10", "0s", "-5s"} {
+		t.Setenv(EnvTimeout, timeout)
+		if _, err := LoadConfig(); err == nil || !strings.Contains(err.Error(), EnvTimeout) {
+			t.Errorf("expected error naming %s for %q, got %v", EnvTimeout, timeout, err)
+		}
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	clearConfigEnv(t)
+
+	_, err := NewClientFromEnv()
+	if !errors.Is(err, ErrNoAPIKey) || !strings.Contains(err.Error(), EnvAPIKey) {
+		t.Errorf("expected ErrNoAPIKey naming %s, got %v", EnvAPIKey, err)
+	}
+
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvBaseURL, "http://localhost:8080/v4")
+	t.Setenv(EnvTimeout, "2s")
+
+	cl, err := NewClientFromEnv(WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cl.apiKey != "env-key" || cl.baseURL.String() != "http://localhost:8080/v4/" {
+		t.Errorf("got key %q and base url %q", cl.apiKey, cl.baseURL)
+	}
+	if cl.client.Timeout != time.Second {
+		t.Errorf("expected options to take precedence, got timeout %v", cl.client.Timeout)
+	}
+
+	t.Setenv(EnvBaseURL, "not a url")
+	if _, err := NewClientFromEnv(); err == nil {
+		t.Error("expected error for invalid base url")
+	}
+}
+
+func TestConfigNewClientHTTPClient(t *testing.T) {
+	custom := &http.Client{}
+	cl, err := Config{APIKey: "abc", Timeout: 3 * time.Second}.NewClient(WithHTTPClient(custom))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cl.client.Timeout != 3*time.Second || custom.Timeout != 0 {
+		t.Errorf("expected config timeout to apply to a copy of the http.Client, got %v", cl.client.Timeout)
+	}
+}
+
+func TestConfigNewSession(t *testing.T) {
+	cl := NewClient("abc")
+
+	_, err := Config{Username: "user"}.NewSession(cl)
+	if !errors.Is(err, ErrNoCredentials) || !strings.Contains(err.Error(), EnvPassword) {
+		t.Errorf("expected ErrNoCredentials naming %s, got %v", EnvPassword, err)
+	}
+
+	s, err := Config{Username: "user", Password: "pass"}.NewSession(cl)
+	if err != nil || s.Client() != cl {
+		t.Errorf("got %v, %v", s, err)
+	}
+}
@@ -0,0 +1,179 @@
+package wordnik
+
+import "fmt"
+
+// PartOfSpeech is a part of speech, as used by query options such as
+// IncludePartOfSpeech and returned in Definition.PartOfSpeech.
+type PartOfSpeech string
+
+// Parts of speech known to the API. The misspelt "posessive" values match the
+// API.
+const (
+	PartOfSpeechNoun                PartOfSpeech = "noun"
+	PartOfSpeechAdjective           PartOfSpeech = "adjective"
+	PartOfSpeechVerb                PartOfSpeech = "verb"
+	PartOfSpeechAdverb              PartOfSpeech = "adverb"
+	PartOfSpeechInterjection        PartOfSpeech = "interjection"
+	PartOfSpeechPronoun             PartOfSpeech = "pronoun"
+	PartOfSpeechPreposition         PartOfSpeech = "preposition"
+	PartOfSpeechAbbreviation        PartOfSpeech = "abbreviation"
+	PartOfSpeechAffix               PartOfSpeech = "affix"
+	PartOfSpeechArticle             PartOfSpeech = "article"
+	PartOfSpeechAuxiliaryVerb       PartOfSpeech = "auxiliary-verb"
+	PartOfSpeechConjunction         PartOfSpeech = "conjunction"
+	PartOfSpeechDefiniteArticle     PartOfSpeech = "definite-article"
+	PartOfSpeechFamilyName          PartOfSpeech = "family-name"
+	PartOfSpeechGivenName           PartOfSpeech = "given-name"
+	PartOfSpeechIdiom               PartOfSpeech = "idiom"
+	PartOfSpeechImperative          PartOfSpeech = "imperative"
+	PartOfSpeechNounPlural          PartOfSpeech = "noun-plural"
+	PartOfSpeechNounPosessive       PartOfSpeech = "noun-posessive"
+	PartOfSpeechPastParticiple      PartOfSpeech = "past-participle"
+	PartOfSpeechPhrasalPrefix       PartOfSpeech = "phrasal-prefix"
+	PartOfSpeechProperNoun          PartOfSpeech = "proper-noun"
+	PartOfSpeechProperNounPlural    PartOfSpeech = "proper-noun-plural"
+	PartOfSpeechProperNounPosessive PartOfSpeech = "proper-noun-posessive"
+	PartOfSpeechSuffix              PartOfSpeech = "suffix"
+	PartOfSpeechVerbIntransitive    PartOfSpeech = "verb-intransitive"
+	PartOfSpeechVerbTransitive      PartOfSpeech = "verb-transitive"
+)
+
+// SourceDictionary is a dictionary definitions can come from, as used by query
+// options such as SourceDictionaries and returned in
+// Definition.SourceDictionary.
+type SourceDictionary string
+
+// Source dictionaries known to the API. SourceDictionaryAll selects every
+// dictionary where that is supported.
+const (
+	SourceDictionaryAll        SourceDictionary = "all"
+	SourceDictionaryAHD        SourceDictionary = "ahd"
+	SourceDictionaryCentury    SourceDictionary = "century"
+	SourceDictionaryWiktionary SourceDictionary = "wiktionary"
+	SourceDictionaryWebster    SourceDictionary = "webster"
+	SourceDictionaryWordnet    SourceDictionary = "wordnet"
+)
+
+// RelationshipType is a relationship between words, as used by the
+// RelationshipTypes query option and returned in RelatedWord.RelationshipType.
+type RelationshipType string
+
+// Relationship types known to the API.
+const (
+	RelationshipTypeSynonym                   RelationshipType = "synonym"
+	RelationshipTypeAntonym                   RelationshipType = "antonym"
+	RelationshipTypeVariant                   RelationshipType = "variant"
+	RelationshipTypeEquivalent                RelationshipType = "equivalent"
+	RelationshipTypeCrossReference            RelationshipType = "cross-reference"
+	RelationshipTypeRelatedWord               RelationshipType = "related-word"
+	RelationshipTypeRhyme                     RelationshipType = "rhyme"
+	RelationshipTypeForm                      RelationshipType = "form"
+	RelationshipTypeEtymologicallyRelatedTerm RelationshipType = "etymologically-related-term"
+	RelationshipTypeHypernym                  RelationshipType = "hypernym"
+	RelationshipTypeHyponym                   RelationshipType = "hyponym"
+	RelationshipTypeInflectedForm             RelationshipType = "inflected-form"
+	RelationshipTypePrimary                   RelationshipType = "primary"
+	RelationshipTypeSameContext               RelationshipType = "same-context"
+	RelationshipTypeVerbForm                  RelationshipType = "verb-form"
+	RelationshipTypeVerbStem                  RelationshipType = "verb-stem"
+)
+
+// PronunciationFormat is a notation for pronunciations, as used by the
+// TypeFormat query option and returned in TextPron.RawType.
+type PronunciationFormat string
+
+// Pronunciation formats known to the API.
+const (
+	PronunciationFormatAHD              PronunciationFormat = "ahd"
+	PronunciationFormatArpabet          PronunciationFormat = "arpabet"
+	PronunciationFormatGCIDEDiacritical PronunciationFormat = "gcide-diacritical"
+	PronunciationFormatIPA              PronunciationFormat = "IPA"
+)
+
+// String implements fmt.Stringer.
+func (p PartOfSpeech) String() string { return string(p) }
+
+// String implements fmt.Stringer.
+func (d SourceDictionary) String() string { return string(d) }
+
+// String implements fmt.Stringer.
+func (r RelationshipType) String() string { return string(r) }
+
+// String implements fmt.Stringer.
+func (f PronunciationFormat) String() string { return string(f) }
+
+// ParsePartOfSpeech returns the PartOfSpeech named s, or an error if it isn't
+// known to the API.
+func ParsePartOfSpeech(s string) (PartOfSpeech, error) {
+	return parseEnum(s, validPartOfSpeech, "part of speech")
+}
+
+// ParseSourceDictionary returns the SourceDictionary named s, or an error if
+// it isn't known to the API.
+func ParseSourceDictionary(s string) (SourceDictionary, error) {
+	return parseEnum(s, validSourceDictionaries, "source dictionary")
+}
+
+// ParseRelationshipType returns the RelationshipType named s, or an error if
+// it isn't known to the API.
+func ParseRelationshipType(s string) (RelationshipType, error) {
+	return parseEnum(s, validRelationshipTypes, "relationship type")
+}
+
+// ParsePronunciationFormat returns the PronunciationFormat named s, or an
+// error if it isn't known to the API.
+func ParsePronunciationFormat(s string) (PronunciationFormat, error) {
+	return parseEnum(s, validTypeFormat, "pronunciation format")
+}
+
+// MarshalText implements encoding.TextMarshaler, and so JSON marshalling.
+func (p PartOfSpeech) MarshalText() ([]byte, error) { return []byte(p), nil }
+
+// MarshalText implements encoding.TextMarshaler, and so JSON marshalling.
+func (d SourceDictionary) MarshalText() ([]byte, error) { return []byte(d), nil }
+
+// MarshalText implements encoding.TextMarshaler, and so JSON marshalling.
+func (r RelationshipType) MarshalText() ([]byte, error) { return []byte(r), nil }
+
+// MarshalText implements encoding.TextMarshaler, and so JSON marshalling.
+func (f PronunciationFormat) MarshalText() ([]byte, error) { return []byte(f), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler, and so JSON unmarshalling.
+// Unlike ParsePartOfSpeech, it accepts values unknown to this package, since
+// the API may return new ones.
+func (p *PartOfSpeech) UnmarshalText(text []byte) error {
+	*p = PartOfSpeech(text)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, and so JSON unmarshalling.
+// Unlike ParseSourceDictionary, it accepts values unknown to this package,
+// since the API may return new ones.
+func (d *SourceDictionary) UnmarshalText(text []byte) error {
+	*d = SourceDictionary(text)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, and so JSON unmarshalling.
+// Unlike ParseRelationshipType, it accepts values unknown to this package,
+// since the API may return new ones.
+func (r *RelationshipType) UnmarshalText(text []byte) error {
+	*r = RelationshipType(text)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, and so JSON unmarshalling.
+// Unlike ParsePronunciationFormat, it accepts values unknown to this package,
+// such as "ahd-5", since the API may return new ones.
+func (f *PronunciationFormat) UnmarshalText(text []byte) error {
+	*f = PronunciationFormat(text)
+	return nil
+}
+
+// parseEnum looks s up in validityMap, describing it as kind if it's unknown.
+func parseEnum[T ~string](s string, validityMap map[T]bool, kind string) (T, error) {
+	if !validityMap[T(s)] {
+		return "", fmt.Errorf("wordnik: unknown %s %q", kind, s)
+	}
+	return T(s), nil
+}
@@ -0,0 +1,97 @@
+package wordnik
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestParseEnums(t *testing.T) {
+	if p, err := ParsePartOfSpeech("verb-transitive"); err != nil || p != PartOfSpeechVerbTransitive {
+		t.Errorf("got %q, %v", p, err)
+	}
+	if d, err := ParseSourceDictionary("wiktionary"); err != nil || d != SourceDictionaryWiktionary {
+		t.Errorf("got %q, %v", d, err)
+	}
+	if r, err := ParseRelationshipType("synonym"); err != nil || r != RelationshipTypeSynonym {
+		t.Errorf("got %q, %v", r, err)
+	}
+	if f, err := ParsePronunciationFormat("IPA"); err != nil || f != PronunciationFormatIPA {
+		t.Errorf("got %q, %v", f, err)
+	}
+
+	invalid := []func() error{
+		func() error { _, err := ParsePartOfSpeech("nouns"); return err },
+		func() error { _, err := ParseSourceDictionary("oed"); return err },
+		func() error { _, err := ParseRelationshipType(""); return err },
+		func() error { _, err := ParsePronunciationFormat("ipa"); return err },
+	}
+	for i, parse := range invalid {
+		if parse() == nil {
+			t.Errorf("case %d: expected error for unknown value", i)
+		}
+	}
+}
+
+func TestEnumStrings(t *testing.T) {
+	strs := []string{
+		PartOfSpeechNounPosessive.String(),
+		SourceDictionaryAHD.String(),
+		RelationshipTypeCrossReference.String(),
+		PronunciationFormatGCIDEDiacritical.String(),
+	}
+	expected := []string{"noun-posessive", "ahd", "cross-reference", "gcide-diacritical"}
+
+	for i := range strs {
+		if strs[i] != expected[i] {
+			t.Errorf("got %q, expected %q", strs[i], expected[i])
+		}
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	var def Definition
+	err := json.Unmarshal([]byte(`{"partOfSpeech": "idiom", "sourceDictionary": "wiktionary", "textProns": [{"raw": "kăt", "rawType": "ahd-5"}]}`), &def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.PartOfSpeech != PartOfSpeechIdiom {
+		t.Errorf("got part of speech %q", def.PartOfSpeech)
+	}
+	if def.SourceDictionary != SourceDictionaryWiktionary {
+		t.Errorf("got source dictionary %q", def.SourceDictionary)
+	}
+	if def.TextProns[0].RawType != "ahd-5" {
+		t.Errorf("expected unknown format to be kept, got %q", def.TextProns[0].RawType)
+	}
+
+	data, err := json.Marshal(RelatedWord{RelationshipType: RelationshipTypeRhyme})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]interface{}
+	json.Unmarshal(data, &decoded)
+	if decoded["relationshipType"] != "rhyme" {
+		t.Errorf("got relationshipType %v", decoded["relationshipType"])
+	}
+
+	byDictionary := map[SourceDictionary]int{SourceDictionaryCentury: 2}
+	data, err = json.Marshal(byDictionary)
+	if err != nil || string(data) != `{"century":2}` {
+		t.Errorf("got %s, %v", data, err)
+	}
+}
+
+func TestEnumOptions(t *testing.T) {
+	q := url.Values{}
+	IncludePartOfSpeech(PartOfSpeechNoun, PartOfSpeechVerb)(&q)
+	RelationshipTypes(RelationshipTypeSynonym)(&q)
+	TypeFormat(PronunciationFormatIPA)(&q)
+	FromSourceDictionary(SourceDictionaryWebster)(&q)
+
+	expected := "includePartOfSpeech=noun%2Cverb%2C&relationshipTypes=synonym%2C&sourceDictionary=webster&typeFormat=IPA"
+	if q.Encode() != expected {
+		t.Errorf("got %q, expected %q", q.Encode(), expected)
+	}
+}
@@ -1,10 +1,12 @@
 package wordnik
 
 import (
-	"errors"
+	"fmt"
 	"os"
 	"sync"
 	"testing"
+
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
 )
 
 var (
@@ -13,45 +15,60 @@ var (
 )
 
 // Helper function for testing which either initializes a Client or returns
-// the already-existing Client
+// the already-existing Client, configured by LoadConfig. Without an API key in
+// the environment or config file, the Client talks to a wordniktest.Server
+// instead of the live API.
 func getClient(t *testing.T) *Client {
 	once.Do(func() {
-		testAPIKey, err := getEnvKey()
+		cfg, err := LoadConfig()
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		cl = NewClient(testAPIKey)
+		if cfg.APIKey == "" {
+			srv := wordniktest.NewServer(wordniktest.DefaultFixtures())
+			cl, err = NewClientWithOptions(wordniktest.DefaultAPIKey, WithBaseURL(srv.BaseURL()))
+		} else {
+			cl, err = cfg.NewClient()
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
 	})
 	return cl
 }
 
-// Helper function for testing which retrieves an API key from
-// the environment variable WORDNIK_API_KEY. Returns an error if
-// no key is found.
-func getEnvKey() (string, error) {
-	keyVar := os.Getenv("WORDNIK_API_KEY")
-	if keyVar != "" {
-		return keyVar, nil
-	}
-
-	return keyVar, errors.New("environment variable WORDNIK_API_KEY not set")
-}
-
 type testUser struct {
 	user, pass string
 }
 
-// Helper function for testing which retrieves a test username and password from
-// the environment variables WORDNIK_TEST_USER and WORDNIK_TEST_PASS. Returns an
-// error if no key is found.
+// Helper function for testing which retrieves a test username and password
+// with LoadConfig, from WORDNIK_USERNAME and WORDNIK_PASSWORD or the config
+// file, falling back to the older WORDNIK_TEST_USER and WORDNIK_TEST_PASS.
+// Returns an error if they aren't set. Without an API key, the credentials of
+// the wordniktest.Server's default user are returned.
 func getEnvUserPass() (testUser, error) {
-	user := os.Getenv("WORDNIK_TEST_USER")
-	pass := os.Getenv("WORDNIK_TEST_PASS")
-	if user == "" || pass == "" {
-		err := errors.New("environment variable(s) WORDNIK_TEST_USER and/or WORDNIK_TEST_PASS not set")
-		return testUser{user, pass}, err
+	cfg, err := LoadConfig()
+	if err != nil {
+		return testUser{}, err
+	}
+
+	if cfg.APIKey == "" {
+		return testUser{wordniktest.DefaultUsername, wordniktest.DefaultPassword}, nil
+	}
+
+	if cfg.Username == "" {
+		cfg.Username = os.Getenv("WORDNIK_TEST_USER")
+	}
+	if cfg.Password == "" {
+		cfg.Password = os.Getenv("WORDNIK_TEST_PASS")
+	}
+
+	if cfg.Username == "" || cfg.Password == "" {
+		err := fmt.Errorf("%w: set %s and %s, or WORDNIK_TEST_USER and WORDNIK_TEST_PASS",
+			ErrNoCredentials, EnvUsername, EnvPassword)
+		return testUser{cfg.Username, cfg.Password}, err
 	}
 
-	return testUser{user, pass}, nil
+	return testUser{cfg.Username, cfg.Password}, nil
 }
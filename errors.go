@@ -0,0 +1,135 @@
+package wordnik
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxErrorBody limits how much of a non-2xx response body is read into an
+// APIError.
+const maxErrorBody = 4096
+
+var (
+	// ErrNotFound matches any APIError with status 404, e.g. an unknown word.
+	ErrNotFound = errors.New("wordnik: not found")
+
+	// ErrUnauthorized matches any APIError with status 401 or 403, e.g. a bad
+	// API key or an expired auth token.
+	ErrUnauthorized = errors.New("wordnik: unauthorized")
+
+	// ErrRateLimited matches any APIError with status 429, returned once the
+	// API key's quota has been exhausted.
+	ErrRateLimited = errors.New("wordnik: rate limited")
+)
+
+// requestIDHeaders are the response headers which may carry a request ID,
+// checked in order.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid"}
+
+// APIError is returned by Client methods when the Wordnik API responds with a
+// non-2xx status code. It can be matched against ErrNotFound, ErrUnauthorized
+// and ErrRateLimited with errors.Is, or extracted with errors.As.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Endpoint is the request path relative to the API base url, such as
+	// "word.json/cat/definitions".
+	Endpoint string
+
+	// Message is the error message provided by the API, or the raw response
+	// body if it was not JSON.
+	Message string
+
+	// RequestID identifies the request on the server side, if provided.
+	RequestID string
+
+	// RetryAfter is the delay requested by the server's Retry-After header,
+	// or zero if none was sent.
+	RetryAfter time.Duration
+}
+
+// apiErrorBody covers the error formats returned by the Wordnik API.
+type apiErrorBody struct {
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("wordnik: %s %s: %d %s", e.Method, e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports whether the APIError matches one of the sentinel errors
+// ErrNotFound, ErrUnauthorized or ErrRateLimited.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// IsNotFound reports whether err is, or wraps, an APIError with status 404.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err is, or wraps, an APIError with status
+// 401 or 403.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsRateLimited reports whether err is, or wraps, an APIError with status 429.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// newAPIError builds an APIError from a non-2xx response to req. The response
+// body is read but not closed. The request is passed separately because
+// middleware may return a response without one.
+func (c *Client) newAPIError(req *http.Request, res *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		Endpoint:   c.relativePath(req.URL),
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+	}
+
+	for _, header := range requestIDHeaders {
+		if id := res.Header.Get(header); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	raw, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+
+	var body apiErrorBody
+	if err := json.Unmarshal(raw, &body); err == nil {
+		apiErr.Message = body.Message
+		if apiErr.Message == "" {
+			apiErr.Message = body.Error
+		}
+	} else {
+		apiErr.Message = strings.TrimSpace(string(raw))
+	}
+
+	return apiErr
+}
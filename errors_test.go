@@ -0,0 +1,66 @@
+package wordnik
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+var apiErrorTests = []struct {
+	status                            int
+	body                              string
+	notFound, unauthorized, rateLimit bool
+	expectedMessage                   string
+}{
+	{404, `{"type":"error","message":"word not found"}`, true, false, false, "word not found"},
+	{401, `{"statusCode":401,"error":"Unauthorized"}`, false, true, false, "Unauthorized"},
+	{403, `forbidden`, false, true, false, "forbidden"},
+	{429, `{"message":"API rate limit exceeded"}`, false, false, true, "API rate limit exceeded"},
+	{500, ``, false, false, false, ""},
+}
+
+func TestAPIError(t *testing.T) {
+	for _, testCase := range apiErrorTests {
+		testCase := testCase
+		cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-1")
+			w.WriteHeader(testCase.status)
+			w.Write([]byte(testCase.body))
+		})
+
+		_, err := cl.GetDefinitions("cat")
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("status %d: expected *APIError, got %v", testCase.status, err)
+			continue
+		}
+
+		if apiErr.StatusCode != testCase.status {
+			t.Errorf("status %d: got StatusCode %d", testCase.status, apiErr.StatusCode)
+		}
+		if apiErr.Endpoint != "word.json/cat/definitions" {
+			t.Errorf("status %d: got Endpoint %q", testCase.status, apiErr.Endpoint)
+		}
+		if apiErr.Message != testCase.expectedMessage {
+			t.Errorf("status %d: got Message %q, expected %q", testCase.status, apiErr.Message, testCase.expectedMessage)
+		}
+		if apiErr.RequestID != "req-1" {
+			t.Errorf("status %d: got RequestID %q", testCase.status, apiErr.RequestID)
+		}
+		if IsNotFound(err) != testCase.notFound || IsUnauthorized(err) != testCase.unauthorized || IsRateLimited(err) != testCase.rateLimit {
+			t.Errorf("status %d: sentinel checks did not match", testCase.status)
+		}
+	}
+}
+
+func TestAPIErrorNilDestination(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := cl.DeleteWordList("token", "missing-list")
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
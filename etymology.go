@@ -0,0 +1,274 @@
+package wordnik
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Etymology is an etymology parsed from one of the XML fragments in an
+// EtymologiesResponse, such as
+//
+//	<ety>[Middle English, from Old English <ets>catt</ets>, from Late Latin <ets>cattus</ets>.]</ety>
+type Etymology struct {
+	// Raw is the fragment the Etymology was parsed from.
+	Raw string
+
+	// Text is the etymology as plain text, without tags or the enclosing
+	// brackets.
+	Text string
+
+	// Markup is the parsed content of the fragment. It is nil if the fragment
+	// couldn't be parsed, in which case only Raw and Text are set.
+	Markup Markup
+
+	// Ancestors are the languages and forms the word descends from, most
+	// recent first, e.g. Old English "catt" then Late Latin "cattus".
+	Ancestors []AncestorForm
+
+	// Languages are the distinct languages of Ancestors, in order.
+	Languages []string
+
+	// Xrefs are the words cross-referenced by the etymology.
+	Xrefs []string
+}
+
+// AncestorForm is a step in an Etymology.
+type AncestorForm struct {
+	// Language is the language of the form, as written in the etymology, e.g.
+	// "Old English" or "ME.".
+	Language string
+
+	// Form is the ancestor word, from an <ets> tag. It is empty when the
+	// etymology names a language without a form, as in "from Old French".
+	Form string
+
+	// Gloss is the meaning given for the form, if any.
+	Gloss string
+}
+
+// etymologyXrefTags are the tags which cross-reference another word.
+var etymologyXrefTags = map[string]bool{"er": true, "xref": true, "internalXref": true}
+
+// etymologyConnectors introduce an ancestor within a clause.
+var etymologyConnectors = []string{"from ", "fr. ", "< ", "ult. ", "akin to ", "and "}
+
+// clauseSeparator separates the clauses of an etymology.
+var clauseSeparator = regexp.MustCompile(`[,;\[\]]`)
+
+// escapedTag matches the start of a tag escaped as "&lt;", but not an escaped
+// "<" used to mean "derived from", as in "&lt; ME.".
+var escapedTag = regexp.MustCompile(`&lt;(/?[A-Za-z])`)
+
+// anyTag matches an XML tag, for stripping fragments which can't be parsed.
+var anyTag = regexp.MustCompile(`</?[A-Za-z][^<>]*>`)
+
+// ParseEtymology parses one fragment of an EtymologiesResponse. Tags escaped
+// within the <ety> element, such as "&lt;ets>", are unescaped first. The
+// ancestors are found heuristically from the clauses of the etymology, so
+// unusual phrasing may leave some only in Text. Fragments which aren't
+// well-formed are handled leniently as in ParseMarkup, or failing that have
+// their tags stripped to give Text; ParseEtymology never fails.
+func ParseEtymology(fragment string) Etymology {
+	ety := Etymology{Raw: fragment}
+
+	content := strings.TrimSpace(fragment)
+	content = strings.TrimPrefix(content, "<ety>")
+	content = strings.TrimSuffix(content, "</ety>")
+	content = escapedTag.ReplaceAllString(content, "<$1")
+
+	markup, err := parseMarkup(content)
+	if err != nil {
+		ety.Text = cleanEtymologyText(html.UnescapeString(anyTag.ReplaceAllString(content, "")))
+		return ety
+	}
+
+	ety.Markup = markup
+	ety.Text = cleanEtymologyText(markup.Text())
+
+	for _, node := range flattenEtymology(markup) {
+		if node.Kind != TextNode && etymologyXrefTags[node.Tag] {
+			word := node.Word
+			if word == "" {
+				word = strings.TrimSpace(Markup(node.Children).Text())
+			}
+			ety.Xrefs = append(ety.Xrefs, word)
+		}
+	}
+
+	ety.Ancestors = etymologyAncestors(markup)
+	seen := map[string]bool{}
+	for _, ancestor := range ety.Ancestors {
+		if ancestor.Language != "" && !seen[ancestor.Language] {
+			seen[ancestor.Language] = true
+			ety.Languages = append(ety.Languages, ancestor.Language)
+		}
+	}
+
+	return ety
+}
+
+// Etymologies parses each fragment in r. See ParseEtymology.
+func (r EtymologiesResponse) Etymologies() []Etymology {
+	etymologies := make([]Etymology, len(r))
+	for i, fragment := range r {
+		etymologies[i] = ParseEtymology(fragment)
+	}
+	return etymologies
+}
+
+// String renders the etymology as plain text, e.g. "Middle English, from Old
+// English catt, from Late Latin cattus."
+func (e Etymology) String() string {
+	return e.Text
+}
+
+// flattenEtymology returns the top-level nodes of m, with any node other than
+// an <ets> tag or cross-reference replaced by its children, so that emphasis
+// and the like don't hide ancestors.
+func flattenEtymology(m Markup) Markup {
+	var nodes Markup
+	for _, node := range m {
+		if node.Kind == TextNode || node.Tag == "ets" || etymologyXrefTags[node.Tag] {
+			nodes = append(nodes, node)
+		} else {
+			nodes = append(nodes, flattenEtymology(node.Children)...)
+		}
+	}
+	return nodes
+}
+
+// etymologyClause is a part of an etymology between commas, semicolons or
+// brackets. Clauses holding an <ets> form are split into the text before it,
+// the form, and the text after it.
+type etymologyClause struct {
+	before, form, after string
+	hasForm             bool
+
+	// continues is set for clauses following a comma, which may gloss the
+	// clause before.
+	continues bool
+}
+
+// etymologyAncestors finds the ancestors in m by splitting it into clauses.
+// A clause holding a form gives an ancestor in the language named before the
+// form. A clause without a form gives an ancestor if it is the first clause,
+// or starts with a connector like "from", and names a language; otherwise, a
+// lowercase clause following a form after a comma is taken as its gloss.
+func etymologyAncestors(m Markup) []AncestorForm {
+	clauses := []etymologyClause{{}}
+	current := func() *etymologyClause { return &clauses[len(clauses)-1] }
+
+	add := func(part string) {
+		if current().hasForm {
+			current().after += part
+		} else {
+			current().before += part
+		}
+	}
+
+	addText := func(text string) {
+		start := 0
+		for _, loc := range clauseSeparator.FindAllStringIndex(text, -1) {
+			add(text[start:loc[0]])
+			clauses = append(clauses, etymologyClause{continues: text[loc[0]] == ','})
+			start = loc[1]
+		}
+		add(text[start:])
+	}
+
+	for _, node := range flattenEtymology(m) {
+		switch {
+		case node.Kind == TextNode:
+			addText(node.Text)
+		case node.Tag == "ets":
+			if current().hasForm {
+				clauses = append(clauses, etymologyClause{})
+			}
+			current().form = strings.TrimSpace(Markup(node.Children).Text())
+			current().hasForm = true
+		default:
+			addText(Markup(node.Children).Text())
+		}
+	}
+
+	nonEmpty := clauses[:0]
+	for _, clause := range clauses {
+		if clause.hasForm || strings.TrimSpace(clause.before) != "" {
+			nonEmpty = append(nonEmpty, clause)
+		}
+	}
+	clauses = nonEmpty
+
+	var ancestors []AncestorForm
+	for i, clause := range clauses {
+		before, connected := stripConnector(strings.TrimSpace(clause.before))
+
+		switch {
+		case clause.hasForm:
+			ancestor := AncestorForm{Form: clause.form, Gloss: trimGloss(clause.after)}
+			if isLanguage(before) {
+				ancestor.Language = before
+			}
+			ancestors = append(ancestors, ancestor)
+
+		case (i == 0 || connected) && isLanguage(trimGloss(before)):
+			ancestors = append(ancestors, AncestorForm{Language: trimGloss(before)})
+
+		case i > 0 && clause.continues && clauses[i-1].hasForm && ancestors[len(ancestors)-1].Gloss == "":
+			if gloss := trimGloss(clause.before); gloss != "" && unicode.IsLower([]rune(gloss)[0]) {
+				ancestors[len(ancestors)-1].Gloss = gloss
+			}
+		}
+	}
+
+	return ancestors
+}
+
+// stripConnector removes a leading connector such as "from" from s, reporting
+// whether there was one.
+func stripConnector(s string) (string, bool) {
+	connected := false
+	for again := true; again; {
+		again = false
+		for _, connector := range etymologyConnectors {
+			if strings.HasPrefix(strings.ToLower(s), connector) {
+				s = strings.TrimSpace(s[len(connector):])
+				connected, again = true, true
+			}
+		}
+	}
+	return s, connected
+}
+
+// isLanguage reports whether s looks like the name of a language, such as
+// "Old English" or "ME.": a few words, each starting with a capital letter.
+func isLanguage(s string) bool {
+	words := strings.Fields(s)
+	if len(words) == 0 || len(words) > 4 {
+		return false
+	}
+
+	for _, word := range words {
+		if !unicode.IsUpper([]rune(word)[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// trimGloss trims space and trailing punctuation from s.
+func trimGloss(s string) string {
+	return strings.TrimSpace(strings.TrimRight(strings.TrimSpace(s), ".:"))
+}
+
+// cleanEtymologyText collapses whitespace in s and removes the brackets around
+// the etymology.
+func cleanEtymologyText(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	}
+	return s
+}
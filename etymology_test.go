@@ -0,0 +1,92 @@
+package wordnik
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEtymology(t *testing.T) {
+	ety := ParseEtymology("<ety>[Middle English <ets>orenge</ets>, from Old French, from Old Provençal <ets>auranja</ets>, from Arabic <ets>nāranj</ets>, from Sanskrit <ets>nāraṅgaḥ</ets>, orange tree.]</ety>")
+
+	expected := []AncestorForm{
+		{Language: "Middle English", Form: "orenge"},
+		{Language: "Old French"},
+		{Language: "Old Provençal", Form: "auranja"},
+		{Language: "Arabic", Form: "nāranj"},
+		{Language: "Sanskrit", Form: "nāraṅgaḥ", Gloss: "orange tree"},
+	}
+	if !reflect.DeepEqual(ety.Ancestors, expected) {
+		t.Errorf("got ancestors %+v\nexpected %+v", ety.Ancestors, expected)
+	}
+
+	languages := []string{"Middle English", "Old French", "Old Provençal", "Arabic", "Sanskrit"}
+	if !reflect.DeepEqual(ety.Languages, languages) {
+		t.Errorf("got languages %q", ety.Languages)
+	}
+
+	text := "Middle English orenge, from Old French, from Old Provençal auranja, from Arabic nāranj, from Sanskrit nāraṅgaḥ, orange tree."
+	if ety.String() != text {
+		t.Errorf("got text %q, expected %q", ety.String(), text)
+	}
+}
+
+func TestParseEtymologyEscaped(t *testing.T) {
+	ety := ParseEtymology("<ety>[&lt; ME. &lt;ets>cat&lt;/ets>, &lt; AS. &lt;ets>cat&lt;/ets>; see &lt;er>kitten&lt;/er>.]</ety>")
+
+	expected := []AncestorForm{
+		{Language: "ME.", Form: "cat"},
+		{Language: "AS.", Form: "cat"},
+	}
+	if !reflect.DeepEqual(ety.Ancestors, expected) {
+		t.Errorf("got ancestors %+v", ety.Ancestors)
+	}
+	if !reflect.DeepEqual(ety.Xrefs, []string{"kitten"}) {
+		t.Errorf("got xrefs %q", ety.Xrefs)
+	}
+	if ety.Text != "< ME. cat, < AS. cat; see kitten." {
+		t.Errorf("got text %q", ety.Text)
+	}
+}
+
+func TestParseEtymologyMalformed(t *testing.T) {
+	ety := ParseEtymology("<ety>[From Latin <ets>felis</ety>")
+	if len(ety.Ancestors) != 1 || ety.Ancestors[0].Form != "felis" || ety.Ancestors[0].Language != "Latin" {
+		t.Errorf("expected unclosed tag to be recovered, got %+v", ety.Ancestors)
+	}
+
+	ety = ParseEtymology("<ety>[Latin <ets>foo</ets></b> and more text]</ety>")
+	if ety.Text != "Latin foo and more text" {
+		t.Errorf("expected text after a stray end tag to be kept, got %q", ety.Text)
+	}
+	if len(ety.Ancestors) != 1 || ety.Ancestors[0].Form != "foo" || ety.Ancestors[0].Language != "Latin" {
+		t.Errorf("got ancestors %+v", ety.Ancestors)
+	}
+
+	ety = ParseEtymology("<ety>[1 < 2 <ets>cattus</ets>]")
+	if ety.Markup != nil || ety.Ancestors != nil {
+		t.Errorf("expected unparseable fragment to give text only, got %+v", ety)
+	}
+	if ety.Text != "1 < 2 cattus" {
+		t.Errorf("got text %q", ety.Text)
+	}
+
+	if ety := ParseEtymology(""); ety.Text != "" || ety.Ancestors != nil {
+		t.Errorf("expected empty etymology, got %+v", ety)
+	}
+}
+
+func TestEtymologiesResponseEtymologies(t *testing.T) {
+	cl := getClient(t)
+	res, err := cl.GetEtymologies("orange")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	etymologies := res.Etymologies()
+	if len(etymologies) != len(res) {
+		t.Fatalf("expected %d etymologies, got %d", len(res), len(etymologies))
+	}
+	if len(etymologies) > 0 && len(etymologies[0].Languages) == 0 {
+		t.Errorf("expected languages for orange, got %+v", etymologies[0])
+	}
+}
@@ -0,0 +1,293 @@
+package wordnik
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileCacheExt is the extension of every entry file in a FileCache directory.
+const fileCacheExt = ".wnc"
+
+// FileCache is a Cache which stores each response in its own file, named after
+// the hash of its key, so that it survives restarts. Once the total size of
+// the entries exceeds the configured maximum, the least recently used entries
+// are removed.
+type FileCache struct {
+	dir      string
+	maxBytes int64
+
+	mu   sync.Mutex
+	size int64
+	now  func() time.Time
+}
+
+// FileCacheEntry describes one entry of a FileCache.
+type FileCacheEntry struct {
+	Key       string
+	Size      int64
+	ExpiresAt time.Time
+	LastUsed  time.Time
+}
+
+// fileCacheHeader is written as the first line of every entry file.
+type fileCacheHeader struct {
+	Key       string    `json:"key"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// NewFileCache opens the FileCache in dir, creating the directory if needed.
+// A maxBytes of zero means no size limit.
+func NewFileCache(dir string, maxBytes int64) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	f := &FileCache{dir: dir, maxBytes: maxBytes, now: time.Now}
+
+	files, err := f.files()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		f.size += file.Size()
+	}
+
+	return f, nil
+}
+
+// Get implements Cache.
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := f.path(key)
+	header, value, err := readFileCacheEntry(path)
+	if err != nil || header.Key != key {
+		return nil, false
+	}
+
+	now := f.now()
+	if !now.Before(header.ExpiresAt) {
+		f.remove(path)
+		return nil, false
+	}
+
+	// The modification time doubles as the last use, for eviction.
+	os.Chtimes(path, now, now)
+	return value, true
+}
+
+// Set implements Cache. Write errors are ignored, leaving the entry uncached.
+func (f *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	header, err := json.Marshal(fileCacheHeader{Key: key, ExpiresAt: f.now().Add(ttl)})
+	if err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(f.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	data := append(append(header, '\n'), value...)
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err != nil || closeErr != nil {
+		return
+	}
+
+	path := f.path(key)
+	var oldSize int64
+	if info, err := os.Stat(path); err == nil {
+		oldSize = info.Size()
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return
+	}
+
+	now := f.now()
+	os.Chtimes(path, now, now)
+	f.size += int64(len(data)) - oldSize
+	f.evict()
+}
+
+// Size returns the total size of the cached entries in bytes.
+func (f *FileCache) Size() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.size
+}
+
+// Entries lists the entries in the cache, most recently used first. Expired
+// entries are included.
+func (f *FileCache) Entries() ([]FileCacheEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	files, err := f.files()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]FileCacheEntry, 0, len(files))
+	for i := len(files) - 1; i >= 0; i-- {
+		header, _, err := readFileCacheEntry(filepath.Join(f.dir, files[i].Name()))
+		if err != nil {
+			continue
+		}
+
+		entries = append(entries, FileCacheEntry{
+			Key:       header.Key,
+			Size:      files[i].Size(),
+			ExpiresAt: header.ExpiresAt,
+			LastUsed:  files[i].ModTime(),
+		})
+	}
+
+	return entries, nil
+}
+
+// Prune removes expired entries, returning how many were removed.
+func (f *FileCache) Prune() (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	files, err := f.files()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	now := f.now()
+	for _, file := range files {
+		path := filepath.Join(f.dir, file.Name())
+		header, _, err := readFileCacheEntry(path)
+		if err != nil || !now.Before(header.ExpiresAt) {
+			f.remove(path)
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// Clear removes every entry from the cache.
+func (f *FileCache) Clear() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	files, err := f.files()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := os.Remove(filepath.Join(f.dir, file.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	f.size = 0
+	return nil
+}
+
+// path returns the file which holds the entry for key.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+fileCacheExt)
+}
+
+// files lists the entry files in the cache directory, least recently used
+// first.
+func (f *FileCache) files() ([]os.FileInfo, error) {
+	dirEntries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []os.FileInfo
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), fileCacheExt) {
+			continue
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, info)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	return files, nil
+}
+
+// evict removes the least recently used entries until the cache fits within
+// its maximum size. f.mu must be held.
+func (f *FileCache) evict() {
+	if f.maxBytes <= 0 || f.size <= f.maxBytes {
+		return
+	}
+
+	files, err := f.files()
+	if err != nil {
+		return
+	}
+
+	for _, file := range files {
+		if f.size <= f.maxBytes {
+			return
+		}
+		f.remove(filepath.Join(f.dir, file.Name()))
+	}
+}
+
+// remove deletes the entry file at path. f.mu must be held.
+func (f *FileCache) remove(path string) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+
+	if os.Remove(path) == nil {
+		f.size -= info.Size()
+	}
+}
+
+// readFileCacheEntry reads the header and value of the entry file at path.
+func readFileCacheEntry(path string) (fileCacheHeader, []byte, error) {
+	var header fileCacheHeader
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return header, nil, err
+	}
+
+	newline := bytes.IndexByte(data, '\n')
+	if newline < 0 {
+		return header, nil, errors.New("malformed cache entry: " + path)
+	}
+
+	if err := json.Unmarshal(data[:newline], &header); err != nil {
+		return header, nil, err
+	}
+
+	return header, data[newline+1:], nil
+}
@@ -0,0 +1,172 @@
+package wordnik
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Helper function for testing which returns a FileCache in a temporary
+// directory with a clock that only moves when the returned function is called.
+func newTestFileCache(t *testing.T, dir string, maxBytes int64) (*FileCache, func(time.Duration)) {
+	f, err := NewFileCache(dir, maxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2017, 6, 12, 0, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+	return f, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	f, advance := newTestFileCache(t, dir, 0)
+
+	f.Set("word.json/cat/definitions?limit=1", []byte(`[{"text":"a feline"}]`), time.Hour)
+	if v, ok := f.Get("word.json/cat/definitions?limit=1"); !ok || string(v) != `[{"text":"a feline"}]` {
+		t.Errorf("expected hit, got %q %v", v, ok)
+	}
+	if _, ok := f.Get("word.json/dog/definitions?limit=1"); ok {
+		t.Error("expected miss for unknown key")
+	}
+
+	// A new FileCache on the same directory sees the same entries.
+	reopened, _ := newTestFileCache(t, dir, 0)
+	if _, ok := reopened.Get("word.json/cat/definitions?limit=1"); !ok {
+		t.Error("expected entry to survive reopening")
+	}
+	if reopened.Size() != f.Size() || f.Size() == 0 {
+		t.Errorf("expected matching non-zero sizes, got %d and %d", reopened.Size(), f.Size())
+	}
+
+	advance(2 * time.Hour)
+	if _, ok := f.Get("word.json/cat/definitions?limit=1"); ok {
+		t.Error("expected entry to expire")
+	}
+	if f.Size() != 0 {
+		t.Errorf("expected expired entry to be removed, size %d", f.Size())
+	}
+}
+
+func TestFileCacheFailedSet(t *testing.T) {
+	f, _ := newTestFileCache(t, t.TempDir(), 0)
+	f.Set("a", []byte("value"), time.Hour)
+	size := f.Size()
+
+	// A non-empty directory in place of the entry makes the rename fail.
+	path := f.path("b")
+	if err := os.MkdirAll(filepath.Join(path, "blocker"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	f.Set("b", []byte("value"), time.Hour)
+	if _, ok := f.Get("b"); ok {
+		t.Error("expected failed Set to leave the entry uncached")
+	}
+	if f.Size() != size {
+		t.Errorf("expected failed Set to leave the size at %d, got %d", size, f.Size())
+	}
+}
+
+func TestFileCacheEviction(t *testing.T) {
+	// Each entry takes about 100 bytes with its header, so three fit.
+	f, advance := newTestFileCache(t, t.TempDir(), 350)
+	value := []byte(strings.Repeat("x", 50))
+
+	for _, key := range []string{"a", "b", "c"} {
+		f.Set(key, value, time.Hour)
+		advance(time.Second)
+	}
+
+	// Using "a" makes "b" the least recently used entry.
+	f.Get("a")
+	advance(time.Second)
+	f.Set("d", value, time.Hour)
+
+	if _, ok := f.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if _, ok := f.Get("a"); !ok {
+		t.Error("expected a to be kept")
+	}
+	if f.Size() > 350 {
+		t.Errorf("expected size within limit, got %d", f.Size())
+	}
+}
+
+func TestFileCacheInspection(t *testing.T) {
+	f, advance := newTestFileCache(t, t.TempDir(), 0)
+	f.Set("old", []byte("1"), time.Minute)
+	advance(time.Second)
+	f.Set("new", []byte("2"), time.Hour)
+
+	entries, err := f.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Key != "new" || entries[1].Key != "old" {
+		t.Errorf("expected entries most recently used first, got %+v", entries)
+	}
+
+	advance(time.Hour - time.Second)
+	if n, err := f.Prune(); err != nil || n != 1 {
+		t.Errorf("expected 1 pruned entry, got %d, %v", n, err)
+	}
+
+	if err := f.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := f.Entries(); len(entries) != 0 || f.Size() != 0 {
+		t.Errorf("expected empty cache after Clear, got %d entries", len(entries))
+	}
+}
+
+func TestWarmCache(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if strings.Contains(r.URL.Path, "notaword") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+
+	if err := cl.WarmCache(context.Background(), []string{"cat"}); err == nil {
+		t.Error("expected error without a cache")
+	}
+
+	f, _ := newTestFileCache(t, t.TempDir(), 0)
+	cl.SetCache(f, time.Hour)
+
+	if err := cl.WarmCache(context.Background(), []string{"cat"}, "RandomWord"); err == nil {
+		t.Error("expected error for uncacheable endpoint")
+	}
+
+	if err := cl.WarmCache(context.Background(), []string{"cat"}, "GetAudio"); err == nil {
+		t.Error("expected error for endpoint which isn't cached")
+	}
+	if calls != 0 {
+		t.Errorf("expected no calls for rejected endpoints, got %d", calls)
+	}
+
+	err := cl.WarmCache(context.Background(), []string{"cat", "notaword", "dog"}, "GetDefinitions", "Hyphenation")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entries, _ := f.Entries(); len(entries) != 4 {
+		t.Errorf("expected 4 cached responses, got %d", len(entries))
+	}
+
+	calls = 0
+	cl.GetDefinitions("dog")
+	if calls != 0 {
+		t.Error("expected warmed entry to be served from the cache")
+	}
+}
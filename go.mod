@@ -0,0 +1,3 @@
+module github.com/rhallora-heidelberg/go-wordnik
+
+go 1.23
@@ -0,0 +1,148 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Instrumentation is notified of every API call made by a Client, so that
+// calls can be traced and measured, for example with OpenTelemetry spans and
+// histograms. The package itself has no dependency on any tracing or metrics
+// library; implementations adapt CallInfo and CallResult to their own.
+//
+// A call is a single invocation of a Client method. It may involve several
+// HTTP attempts when retried, or none when served from the Cache or shared
+// with an identical request already in flight.
+type Instrumentation interface {
+	// StartCall is called before a call begins. The returned context is used
+	// for the call's requests, which lets trace context propagate through
+	// Middleware. The returned function is called exactly once, when the
+	// call ends.
+	StartCall(ctx context.Context, call CallInfo) (context.Context, func(CallResult))
+}
+
+// CallInfo describes an API call as it starts.
+type CallInfo struct {
+	// Endpoint is the name of the Client method, such as "GetDefinitions".
+	// A span for the call might be named "wordnik." + Endpoint.
+	Endpoint string
+
+	// Method is the HTTP method of the call.
+	Method string
+
+	// Path is the request path relative to the API base url.
+	Path string
+
+	// Word is the word being looked up, for endpoints under word.json.
+	Word string
+}
+
+// CallResult describes an API call once it has ended.
+type CallResult struct {
+	// StatusCode is the status of the final HTTP response, or zero if none
+	// was received or the call was served from the cache.
+	StatusCode int
+
+	// Retries is the number of attempts made after the first.
+	Retries int
+
+	// CacheHit reports whether the response came from the Client's Cache.
+	CacheHit bool
+
+	// Duration is how long the call took.
+	Duration time.Duration
+
+	// Err is the error returned by the call, if any.
+	Err error
+}
+
+// SetInstrumentation makes the Client report every call to inst. A nil inst
+// disables instrumentation. To report to several Instrumentations, such as
+// tracing and a metrics exporter, combine them with MultiInstrumentation. It
+// should be called before the Client is used concurrently.
+func (c *Client) SetInstrumentation(inst Instrumentation) {
+	c.instrumentation = inst
+}
+
+// MultiInstrumentation returns an Instrumentation which reports every call to
+// each of insts. Calls are started in order, each with the context returned by
+// the one before, and ended in reverse order. Nil Instrumentations are
+// skipped.
+func MultiInstrumentation(insts ...Instrumentation) Instrumentation {
+	var multi multiInstrumentation
+	for _, inst := range insts {
+		if inst != nil {
+			multi = append(multi, inst)
+		}
+	}
+	return multi
+}
+
+// multiInstrumentation fans calls out to several Instrumentations.
+type multiInstrumentation []Instrumentation
+
+func (m multiInstrumentation) StartCall(ctx context.Context, call CallInfo) (context.Context, func(CallResult)) {
+	ends := make([]func(CallResult), len(m))
+	for i, inst := range m {
+		ctx, ends[i] = inst.StartCall(ctx, call)
+	}
+
+	return ctx, func(res CallResult) {
+		for i := len(ends) - 1; i >= 0; i-- {
+			ends[i](res)
+		}
+	}
+}
+
+// startCall notifies the Client's Instrumentation that a call to endpoint is
+// starting, returning the context to make the call with and a function to end
+// it.
+func (c *Client) startCall(ctx context.Context, endpoint, method, path string) (context.Context, func(response, bool, error)) {
+	if c.instrumentation == nil {
+		return ctx, func(response, bool, error) {}
+	}
+
+	info := CallInfo{
+		Endpoint: endpoint,
+		Method:   method,
+		Path:     path,
+		Word:     wordFromPath(path),
+	}
+
+	start := time.Now()
+	ctx, end := c.instrumentation.StartCall(ctx, info)
+
+	return ctx, func(res response, cacheHit bool, err error) {
+		result := CallResult{
+			StatusCode: res.statusCode,
+			CacheHit:   cacheHit,
+			Duration:   time.Since(start),
+			Err:        err,
+		}
+
+		if res.attempts > 1 {
+			result.Retries = res.attempts - 1
+		}
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			result.StatusCode = apiErr.StatusCode
+		}
+
+		end(result)
+	}
+}
+
+// wordFromPath extracts the word from paths like "word.json/cat/definitions".
+func wordFromPath(path string) string {
+	if !strings.HasPrefix(path, "word.json/") {
+		return ""
+	}
+
+	word := strings.TrimPrefix(path, "word.json/")
+	if i := strings.Index(word, "/"); i >= 0 {
+		word = word[:i]
+	}
+	return word
+}
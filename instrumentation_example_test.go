@@ -0,0 +1,96 @@
+package wordnik_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	wordnik "github.com/rhallora-heidelberg/go-wordnik"
+)
+
+// The tracer, span, histogram and counter below stand in for OpenTelemetry's
+// trace.Tracer, trace.Span, metric.Float64Histogram and metric.Int64Counter,
+// so that the adapter in the example compiles without depending on
+// OpenTelemetry. With the real packages, only the types and attribute helpers
+// change.
+
+type tracer struct{}
+
+func (tracer) Start(ctx context.Context, name string, attrs ...attribute) (context.Context, *span) {
+	return ctx, &span{name: name, attrs: attrs}
+}
+
+type attribute struct {
+	key   string
+	value interface{}
+}
+
+type span struct {
+	name  string
+	attrs []attribute
+	err   error
+}
+
+func (s *span) SetAttributes(attrs ...attribute) { s.attrs = append(s.attrs, attrs...) }
+func (s *span) RecordError(err error)            { s.err = err }
+func (s *span) End()                             { fmt.Println(s.name, s.attrs, "error:", s.err != nil) }
+
+type histogram struct{ count int }
+
+func (h *histogram) Record(ctx context.Context, seconds float64, attrs ...attribute) { h.count++ }
+
+type counter struct{ count int64 }
+
+func (c *counter) Add(ctx context.Context, n int64, attrs ...attribute) { c.count += n }
+
+// otelInstrumentation creates a span per API call, and records call latency
+// and errors by endpoint.
+type otelInstrumentation struct {
+	tracer  tracer
+	latency *histogram
+	errors  *counter
+}
+
+func (o otelInstrumentation) StartCall(ctx context.Context, call wordnik.CallInfo) (context.Context, func(wordnik.CallResult)) {
+	ctx, span := o.tracer.Start(ctx, "wordnik."+call.Endpoint, attribute{"wordnik.word", call.Word})
+
+	return ctx, func(res wordnik.CallResult) {
+		endpoint := attribute{"wordnik.endpoint", call.Endpoint}
+		span.SetAttributes(
+			attribute{"http.status_code", res.StatusCode},
+			attribute{"wordnik.retries", res.Retries},
+		)
+		o.latency.Record(ctx, res.Duration.Seconds(), endpoint)
+		if res.Err != nil {
+			span.RecordError(res.Err)
+			o.errors.Add(ctx, 1, endpoint)
+		}
+		span.End()
+	}
+}
+
+func ExampleInstrumentation() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v4/word.json/wombat" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"word":"cat"}`))
+	}))
+	defer srv.Close()
+
+	cl, _ := wordnik.NewClientWithOptions("key", wordnik.WithBaseURL(srv.URL+"/v4"))
+
+	inst := otelInstrumentation{latency: &histogram{}, errors: &counter{}}
+	cl.SetInstrumentation(inst)
+
+	cl.GetWord("cat")
+	cl.GetWord("wombat")
+	fmt.Println("calls:", inst.latency.count, "errors:", inst.errors.count)
+
+	// Output:
+	// wordnik.GetWord [{wordnik.word cat} {http.status_code 200} {wordnik.retries 0}] error: false
+	// wordnik.GetWord [{wordnik.word wombat} {http.status_code 404} {wordnik.retries 0}] error: true
+	// calls: 2 errors: 1
+}
@@ -0,0 +1,143 @@
+package wordnik
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type spanKey struct{}
+
+// recordingInstrumentation records every call it is notified of, and marks the
+// call's context so that propagation can be checked.
+type recordingInstrumentation struct {
+	mu      sync.Mutex
+	infos   []CallInfo
+	results []CallResult
+}
+
+func (r *recordingInstrumentation) StartCall(ctx context.Context, call CallInfo) (context.Context, func(CallResult)) {
+	r.mu.Lock()
+	r.infos = append(r.infos, call)
+	r.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, "wordnik."+call.Endpoint), func(res CallResult) {
+		r.mu.Lock()
+		r.results = append(r.results, res)
+		r.mu.Unlock()
+	}
+}
+
+func TestInstrumentation(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 1, http.StatusServiceUnavailable, `[]`))
+	cl.SetRetryPolicy(fastRetryPolicy())
+	cl.SetCache(NewMemoryCache(10), time.Hour)
+
+	var spans []interface{}
+	cl.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			spans = append(spans, req.Context().Value(spanKey{}))
+			return next.Do(req)
+		})
+	})
+
+	inst := &recordingInstrumentation{}
+	cl.SetInstrumentation(inst)
+
+	cl.GetDefinitions("cat")
+	cl.GetDefinitions("cat")
+	cl.DeleteWordList("token", "list")
+
+	if len(inst.infos) != 3 || len(inst.results) != 3 {
+		t.Fatalf("expected 3 calls, got %d started and %d ended", len(inst.infos), len(inst.results))
+	}
+
+	info := inst.infos[0]
+	if info.Endpoint != "GetDefinitions" || info.Word != "cat" || info.Method != "GET" || info.Path != "word.json/cat/definitions" {
+		t.Errorf("unexpected call info %+v", info)
+	}
+
+	res := inst.results[0]
+	if res.StatusCode != 200 || res.Retries != 1 || res.CacheHit || res.Err != nil || res.Duration <= 0 {
+		t.Errorf("unexpected result for retried call %+v", res)
+	}
+
+	if !inst.results[1].CacheHit {
+		t.Errorf("expected second call to be a cache hit, got %+v", inst.results[1])
+	}
+
+	if inst.infos[2].Endpoint != "DeleteWordList" || inst.infos[2].Word != "" || inst.results[2].StatusCode != 200 {
+		t.Errorf("unexpected DeleteWordList call %+v %+v", inst.infos[2], inst.results[2])
+	}
+
+	if len(spans) != 3 || spans[0] != "wordnik.GetDefinitions" || spans[2] != "wordnik.DeleteWordList" {
+		t.Errorf("expected call context to reach middleware, got %v", spans)
+	}
+}
+
+func TestInstrumentationErrors(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 5, http.StatusNotFound, `{}`))
+	inst := &recordingInstrumentation{}
+	cl.SetInstrumentation(inst)
+
+	_, err := cl.GetWord("notaword")
+	if len(inst.results) != 1 || inst.results[0].Err != err || inst.results[0].StatusCode != 404 {
+		t.Errorf("expected 404 to be reported, got %+v", inst.results)
+	}
+
+	if wordFromPath("words.json/search/cat") != "" || wordFromPath("word.json/cat") != "cat" {
+		t.Error("unexpected word extracted from path")
+	}
+}
+
+// orderInstrumentation records when calls start and end under its name.
+type orderInstrumentation struct {
+	name  string
+	order *[]string
+}
+
+func (o orderInstrumentation) StartCall(ctx context.Context, call CallInfo) (context.Context, func(CallResult)) {
+	*o.order = append(*o.order, o.name+" start")
+	return ctx, func(CallResult) {
+		*o.order = append(*o.order, o.name+" end")
+	}
+}
+
+func TestMultiInstrumentation(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	var span interface{}
+	cl.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			span = req.Context().Value(spanKey{})
+			return next.Do(req)
+		})
+	})
+
+	var order []string
+	inst := &recordingInstrumentation{}
+	cl.SetInstrumentation(MultiInstrumentation(orderInstrumentation{"first", &order}, nil, inst, orderInstrumentation{"last", &order}))
+
+	if _, err := cl.GetWord("cat"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inst.results) != 1 || inst.results[0].StatusCode != 200 {
+		t.Errorf("expected call to be reported, got %+v", inst.results)
+	}
+	if span != "wordnik.GetWord" {
+		t.Errorf("expected context to propagate through every instrumentation, got %v", span)
+	}
+
+	expected := "first start,last start,last end,first end"
+	if strings.Join(order, ",") != expected {
+		t.Errorf("got order %q, expected %q", strings.Join(order, ","), expected)
+	}
+}
@@ -0,0 +1,369 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KeySelection determines which API key a KeyPool uses for each request.
+type KeySelection int
+
+const (
+	// SelectRoundRobin uses each key in turn. This is the default.
+	SelectRoundRobin KeySelection = iota
+
+	// SelectLeastUsed uses the key which has made the fewest requests.
+	SelectLeastUsed
+
+	// SelectQuotaAware uses the key with the most calls remaining in its
+	// quota, as last reported by GetAPITokenStatus via SyncKeyPool and
+	// counted down since. Keys whose quota hasn't been synced are used first.
+	SelectQuotaAware
+)
+
+// defaultKeyCooldown is how long a rejected key is avoided, unless set by
+// KeyPool.Cooldown.
+const defaultKeyCooldown = time.Minute
+
+// KeyStats is a snapshot of the usage of one key in a KeyPool.
+type KeyStats struct {
+	Key string
+
+	// Requests counts the requests made with the key, including those which
+	// failed.
+	Requests int64
+
+	// Unauthorized and RateLimited count the requests rejected with 401 and
+	// 429 respectively.
+	Unauthorized int64
+	RateLimited  int64
+
+	// Remaining is the number of calls left in the key's quota, as last
+	// reported by GetAPITokenStatus and counted down since, or -1 if unknown.
+	Remaining int64
+
+	// ResetsAt is when the key's quota window resets, if known.
+	ResetsAt time.Time
+
+	// CoolingUntil is when the key will be used again after being rejected.
+	// It is zero, or in the past, for keys in use.
+	CoolingUntil time.Time
+}
+
+// KeyPool spreads requests across several API keys. Keys which are rejected
+// with 401 Unauthorized or 429 Too Many Requests are avoided for a while, and
+// the request is retried straight away with another key. A 401 for a request
+// made with a user's auth token, or to authenticate a user, is left to the
+// caller, such as a Session, since it is the user's credentials which were
+// rejected. A KeyPool is safe for concurrent use.
+type KeyPool struct {
+	// Cooldown is how long a key is avoided after a 401, or a 429 without a
+	// Retry-After header. Zero means one minute.
+	Cooldown time.Duration
+
+	mu        sync.Mutex
+	selection KeySelection
+	keys      []*KeyStats
+	next      int
+	now       func() time.Time
+}
+
+// NewKeyPool creates a KeyPool which chooses between keys according to
+// selection.
+func NewKeyPool(selection KeySelection, keys ...string) *KeyPool {
+	p := &KeyPool{selection: selection, now: time.Now}
+	for _, key := range keys {
+		p.keys = append(p.keys, &KeyStats{Key: key, Remaining: -1})
+	}
+	return p
+}
+
+// Keys returns the keys in the pool, in the order given to NewKeyPool.
+func (p *KeyPool) Keys() []string {
+	keys := make([]string, len(p.keys))
+	for i, stats := range p.keys {
+		keys[i] = stats.Key
+	}
+	return keys
+}
+
+// Stats returns the usage of each key, in the order given to NewKeyPool.
+func (p *KeyPool) Stats() []KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]KeyStats, len(p.keys))
+	for i, key := range p.keys {
+		stats[i] = *key
+	}
+	return stats
+}
+
+// Sync records the quota status of key, as reported by GetAPITokenStatus.
+func (p *KeyPool) Sync(key string, status APITokenStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if stats := p.find(key); stats != nil {
+		stats.Remaining = status.RemainingCalls
+		stats.ResetsAt = p.now().Add(time.Duration(status.ResetsInMillis) * time.Millisecond)
+	}
+}
+
+// acquire chooses a key for a request and counts the request against it. Keys
+// in skip, which have already failed the request, are only chosen if every key
+// is in skip. It returns false if the pool is empty.
+func (p *KeyPool) acquire(skip map[string]bool) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.keys) == 0 {
+		return "", false
+	}
+
+	now := p.now()
+	var candidates []int
+	for i, stats := range p.keys {
+		if !skip[stats.Key] && !now.Before(stats.CoolingUntil) {
+			candidates = append(candidates, i)
+		}
+	}
+
+	// With every key cooling down, use the one which will recover first.
+	if len(candidates) == 0 {
+		soonest := 0
+		for i, stats := range p.keys {
+			if skip[p.keys[soonest].Key] || (!skip[stats.Key] && stats.CoolingUntil.Before(p.keys[soonest].CoolingUntil)) {
+				soonest = i
+			}
+		}
+		candidates = []int{soonest}
+	}
+
+	chosen := p.choose(candidates, now)
+	stats := p.keys[chosen]
+	stats.Requests++
+	if stats.Remaining > 0 {
+		stats.Remaining--
+	}
+	return stats.Key, true
+}
+
+// choose picks one of candidates, which are indexes into p.keys, according to
+// the pool's KeySelection. p.mu must be held.
+func (p *KeyPool) choose(candidates []int, now time.Time) int {
+	switch p.selection {
+	case SelectLeastUsed:
+		best := candidates[0]
+		for _, i := range candidates[1:] {
+			if p.keys[i].Requests < p.keys[best].Requests {
+				best = i
+			}
+		}
+		return best
+
+	case SelectQuotaAware:
+		remaining := func(stats *KeyStats) int64 {
+			if stats.Remaining < 0 || (!stats.ResetsAt.IsZero() && !now.Before(stats.ResetsAt)) {
+				return math.MaxInt64
+			}
+			return stats.Remaining
+		}
+
+		best := candidates[0]
+		for _, i := range candidates[1:] {
+			a, b := remaining(p.keys[i]), remaining(p.keys[best])
+			if a > b || (a == b && p.keys[i].Requests < p.keys[best].Requests) {
+				best = i
+			}
+		}
+		return best
+
+	default:
+		for _, i := range candidates {
+			if i >= p.next {
+				p.next = i + 1
+				return i
+			}
+		}
+		p.next = candidates[0] + 1
+		return candidates[0]
+	}
+}
+
+// reject records that key was rejected with statusCode, which is 401 or 429,
+// and avoids it for the cooldown period, or retryAfter if given.
+func (p *KeyPool) reject(key string, statusCode int, retryAfter time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.find(key)
+	if stats == nil {
+		return
+	}
+
+	cooldown := p.Cooldown
+	if cooldown == 0 {
+		cooldown = defaultKeyCooldown
+	}
+
+	if statusCode == http.StatusTooManyRequests {
+		stats.RateLimited++
+		stats.Remaining = 0
+		if retryAfter > 0 {
+			cooldown = retryAfter
+		}
+	} else {
+		stats.Unauthorized++
+	}
+
+	stats.CoolingUntil = p.now().Add(cooldown)
+}
+
+// find returns the stats for key, or nil if it isn't in the pool. p.mu must be
+// held.
+func (p *KeyPool) find(key string) *KeyStats {
+	for _, stats := range p.keys {
+		if stats.Key == key {
+			return stats
+		}
+	}
+	return nil
+}
+
+// SetKeyPool makes the Client choose an API key from p for every request,
+// instead of using the key it was created with. A nil pool restores the
+// Client's own key. It should be called before the Client is used
+// concurrently.
+func (c *Client) SetKeyPool(p *KeyPool) {
+	c.keyPool = p
+}
+
+// SyncKeyPool updates the quota of every key in the Client's KeyPool from
+// GetAPITokenStatus. It returns the first error encountered, after trying
+// every key.
+func (c *Client) SyncKeyPool(ctx context.Context) error {
+	if c.keyPool == nil {
+		return errors.New("no key pool set")
+	}
+
+	var firstErr error
+	for _, key := range c.keyPool.Keys() {
+		status, err := c.GetAPITokenStatusContext(BypassCoalescing(withAPIKey(ctx, key)))
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		c.keyPool.Sync(key, status)
+	}
+
+	return firstErr
+}
+
+// StartKeyPoolSync calls SyncKeyPool every interval until ctx is done. Errors
+// are passed to onError if it is non-nil. It returns an error, without
+// starting, if interval isn't positive.
+func (c *Client) StartKeyPoolSync(ctx context.Context, interval time.Duration, onError func(error)) error {
+	if interval <= 0 {
+		return errors.New("key pool sync interval must be positive")
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.SyncKeyPool(ctx); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+type apiKeyKey struct{}
+
+// withAPIKey marks ctx so that requests made with it use key, rather than one
+// chosen by the Client's KeyPool.
+func withAPIKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyKey{}, key)
+}
+
+// sendWithKeyPool makes a single attempt at sending req, with a key from the
+// Client's KeyPool if it has one. If the key is rejected with 401 or 429, the
+// request is sent again straight away with each of the other keys in turn, as
+// long as its body can be rewound. A 401 for a request with user credentials
+// is returned as is.
+func (c *Client) sendWithKeyPool(req *http.Request) ([]byte, int, error) {
+	if key, ok := req.Context().Value(apiKeyKey{}).(string); ok {
+		req.Header["api_key"] = []string{key}
+		return c.sendRequest(req)
+	}
+
+	if c.keyPool == nil {
+		return c.sendRequest(req)
+	}
+
+	tried := map[string]bool{}
+	for {
+		key, ok := c.keyPool.acquire(tried)
+		if !ok {
+			return c.sendRequest(req)
+		}
+		tried[key] = true
+		req.Header["api_key"] = []string{key}
+
+		body, statusCode, err := c.sendRequest(req)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || (statusCode != http.StatusUnauthorized && statusCode != http.StatusTooManyRequests) {
+			return body, statusCode, err
+		}
+		if statusCode == http.StatusUnauthorized && c.hasUserCredentials(req) {
+			return body, statusCode, err
+		}
+
+		c.keyPool.reject(key, statusCode, apiErr.RetryAfter)
+		if len(tried) >= len(c.keyPool.keys) || !rewind(req) {
+			return body, statusCode, err
+		}
+	}
+}
+
+// hasUserCredentials reports whether req carries a user's auth token or
+// password, so that a 401 response may be about those rather than the API key.
+func (c *Client) hasUserCredentials(req *http.Request) bool {
+	return len(req.Header["auth_token"]) > 0 ||
+		strings.HasPrefix(c.relativePath(req.URL), "account.json/authenticate/")
+}
+
+// rewind prepares req's body to be sent again, reporting whether that is
+// possible.
+func rewind(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
@@ -0,0 +1,189 @@
+package wordnik
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
+)
+
+// Helper function for testing which returns a Client using a KeyPool of keys
+// against a wordniktest.Server accepting only valid.
+func newPoolClient(t *testing.T, pool *KeyPool, quota int64, valid ...string) (*Client, *wordniktest.Server) {
+	fixtures := wordniktest.DefaultFixtures()
+	fixtures.APIKeys = valid
+	fixtures.Quota = quota
+
+	return newFixtureClient(t, fixtures, "", WithKeyPool(pool))
+}
+
+// requestsByKey returns the number of requests made with each key in pool.
+func requestsByKey(pool *KeyPool) []int64 {
+	var requests []int64
+	for _, stats := range pool.Stats() {
+		requests = append(requests, stats.Requests)
+	}
+	return requests
+}
+
+func TestKeyPoolRoundRobin(t *testing.T) {
+	pool := NewKeyPool(SelectRoundRobin, "a", "b", "c")
+	cl, srv := newPoolClient(t, pool, 0, "a", "b", "c")
+
+	for i := 0; i < 6; i++ {
+		if _, err := cl.GetWord("potato"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, n := range requestsByKey(pool) {
+		if n != 2 {
+			t.Errorf("expected 2 requests with key %d, got %d", i, n)
+		}
+	}
+
+	if requests := srv.Requests(); len(requests) != 6 {
+		t.Errorf("expected 6 requests, got %d", len(requests))
+	}
+}
+
+func TestKeyPoolFailover(t *testing.T) {
+	pool := NewKeyPool(SelectRoundRobin, "revoked", "good")
+	cl, _ := newPoolClient(t, pool, 0, "good")
+
+	for i := 0; i < 3; i++ {
+		if _, err := cl.GetWord("potato"); err != nil {
+			t.Fatalf("request %d: expected failover to the good key, got %v", i, err)
+		}
+	}
+
+	stats := pool.Stats()
+	if stats[0].Unauthorized != 1 || stats[0].Requests != 1 {
+		t.Errorf("expected the revoked key to be tried once, got %+v", stats[0])
+	}
+	if !stats[0].CoolingUntil.After(time.Now()) {
+		t.Error("expected the revoked key to be cooling down")
+	}
+	if stats[1].Requests != 3 {
+		t.Errorf("expected 3 requests with the good key, got %d", stats[1].Requests)
+	}
+}
+
+func TestKeyPoolRateLimited(t *testing.T) {
+	pool := NewKeyPool(SelectRoundRobin, "a", "b")
+	cl, _ := newPoolClient(t, pool, 2, "a", "b")
+
+	for i := 0; i < 4; i++ {
+		if _, err := cl.GetWord("potato"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := cl.GetWord("potato")
+	if !IsRateLimited(err) {
+		t.Errorf("expected rate limited error once both keys are exhausted, got %v", err)
+	}
+
+	for _, stats := range pool.Stats() {
+		if stats.RateLimited != 1 || stats.Remaining != 0 {
+			t.Errorf("expected key %s to be rate limited once, got %+v", stats.Key, stats)
+		}
+	}
+}
+
+func TestKeyPoolQuotaAware(t *testing.T) {
+	pool := NewKeyPool(SelectQuotaAware, "a", "b")
+	cl, srv := newPoolClient(t, pool, 100, "a", "b")
+
+	other, err := NewClientWithOptions("b", WithBaseURL(srv.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		other.GetWord("potato" + strconv.Itoa(i))
+	}
+
+	if err := cl.SyncKeyPool(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := pool.Stats()
+	if stats[0].Remaining != 100 || stats[1].Remaining != 90 {
+		t.Errorf("expected 100 and 90 calls remaining, got %d and %d", stats[0].Remaining, stats[1].Remaining)
+	}
+
+	for i := 0; i < 12; i++ {
+		cl.GetWord("potato")
+	}
+
+	stats = pool.Stats()
+	if stats[0].Requests != 11 || stats[1].Requests != 1 {
+		t.Errorf("expected the key with the most quota to be preferred, got %v", requestsByKey(pool))
+	}
+}
+
+func TestKeyPoolLeastUsed(t *testing.T) {
+	pool := NewKeyPool(SelectLeastUsed, "a", "b", "c")
+	pool.keys[0].Requests = 3
+	pool.keys[1].Requests = 1
+
+	for i := 0; i < 4; i++ {
+		pool.acquire(nil)
+	}
+
+	expected := []int64{3, 3, 2}
+	for i, n := range requestsByKey(pool) {
+		if n != expected[i] {
+			t.Errorf("got %v, expected %v", requestsByKey(pool), expected)
+			break
+		}
+	}
+}
+
+func TestKeyPoolSession(t *testing.T) {
+	pool := NewKeyPool(SelectRoundRobin, "a", "b", "c")
+	cl, srv := newPoolClient(t, pool, 0, "a", "b", "c")
+	s := NewSession(cl, wordniktest.DefaultUsername, wordniktest.DefaultPassword)
+
+	if _, err := s.GetUser(); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ExpireTokens()
+	if _, err := s.GetUser(); err != nil {
+		t.Fatalf("expected Session to authenticate again, got %v", err)
+	}
+	if authentications(srv) != 2 {
+		t.Errorf("expected 2 authentications, got %d", authentications(srv))
+	}
+
+	if _, err := NewSession(cl, wordniktest.DefaultUsername, "wrong").GetUser(); !IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error for bad password, got %v", err)
+	}
+
+	for _, stats := range pool.Stats() {
+		if stats.Unauthorized != 0 || !stats.CoolingUntil.IsZero() {
+			t.Errorf("expected user 401s not to count against key %s, got %+v", stats.Key, stats)
+		}
+	}
+}
+
+func TestStartKeyPoolSyncInterval(t *testing.T) {
+	pool := NewKeyPool(SelectRoundRobin, "a")
+	cl, _ := newPoolClient(t, pool, 0, "a")
+
+	if err := cl.StartKeyPoolSync(context.Background(), -time.Second, nil); err == nil {
+		t.Error("expected error for negative interval")
+	}
+}
+
+func TestWithKeyPoolEmpty(t *testing.T) {
+	if _, err := NewClientWithOptions("", WithKeyPool(NewKeyPool(SelectRoundRobin))); err == nil {
+		t.Error("expected error for empty key pool")
+	}
+	if _, err := NewClientWithOptions("", WithKeyPool(nil)); err == nil {
+		t.Error("expected error for nil key pool")
+	}
+}
@@ -0,0 +1,121 @@
+package wordnik
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// redactedParams holds the query parameters which are never logged.
+var redactedParams = map[string]bool{"password": true}
+
+// SetLogger makes the Client log every request attempt to logger: its method,
+// endpoint path and query parameters, and the response status, latency and
+// size. Headers are not logged, and credentials in the query are always
+// redacted. Successful requests are logged at debug level and failures at warn
+// level. A nil logger disables logging. It should be called before the Client
+// is used concurrently.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// loggingDoer wraps a Doer, logging each request it sends.
+type loggingDoer struct {
+	next    Doer
+	logger  *slog.Logger
+	baseURL string
+}
+
+func (d loggingDoer) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := d.next.Do(req)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", strings.TrimPrefix(req.URL.Path, d.baseURL)),
+		slog.Any("query", redactQuery(req)),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Duration("latency", time.Since(start)), slog.String("error", redactError(req, err)))
+		d.logger.LogAttrs(req.Context(), slog.LevelWarn, "wordnik request failed", attrs...)
+		return res, err
+	}
+
+	// The response is logged once its body has been read, so that the size
+	// and latency cover the whole transfer.
+	res.Body = &loggedBody{
+		ReadCloser: res.Body,
+		log: func(size int64) {
+			level := slog.LevelDebug
+			if res.StatusCode < 200 || res.StatusCode > 299 {
+				level = slog.LevelWarn
+			}
+
+			attrs = append(attrs,
+				slog.Int("status", res.StatusCode),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int64("size", size),
+			)
+			d.logger.LogAttrs(req.Context(), level, "wordnik request", attrs...)
+		},
+	}
+
+	return res, nil
+}
+
+// loggedBody counts the bytes read from a response body and logs when it is
+// closed.
+type loggedBody struct {
+	io.ReadCloser
+	size   int64
+	log    func(size int64)
+	closed bool
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		b.log(b.size)
+	}
+	return err
+}
+
+// redactQuery returns the request's query parameters with credentials
+// replaced.
+func redactQuery(req *http.Request) map[string][]string {
+	query := req.URL.Query()
+	for param := range query {
+		if redactedParams[param] {
+			query[param] = []string{redacted}
+		}
+	}
+	return query
+}
+
+// redactError returns the message of err, a failure to send req, with the
+// request URL it contains replaced by one with credentials redacted.
+func redactError(req *http.Request, err error) string {
+	msg := err.Error()
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && urlErr.URL != "" {
+		u := *req.URL
+		u.RawQuery = url.Values(redactQuery(req)).Encode()
+		msg = strings.ReplaceAll(msg, urlErr.URL, u.String())
+	}
+	return msg
+}
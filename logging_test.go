@@ -0,0 +1,72 @@
+package wordnik
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogging(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"token":"t"}`))
+	})
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	cl.SetLogger(logger)
+	cl.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("Authorization", "Bearer secret-bearer")
+			return next.Do(req)
+		})
+	})
+
+	if _, err := cl.AuthenticateGET("user", "secret-pass"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cl.GetWordListWords("secret-token", "missing")
+
+	// A transport failure's error contains the request URL.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	failing, err := NewClientWithOptions("abc", WithBaseURL(closed.URL+"/v4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	failing.SetLogger(logger)
+	if _, err := failing.AuthenticateGET("user", "secret-pass"); err == nil {
+		t.Fatal("expected an error from a closed server")
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"secret-pass", "secret-token", "secret-bearer", "abc"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("expected %q to be redacted from log:\n%s", secret, out)
+		}
+	}
+
+	for _, expected := range []string{
+		"level=DEBUG",
+		"method=GET",
+		"path=account.json/authenticate/user",
+		"status=200",
+		"size=13",
+		"latency=",
+		"level=WARN",
+		"status=404",
+		"password:[REDACTED]",
+		"wordnik request failed",
+		"password=REDACTED",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected log to contain %q:\n%s", expected, out)
+		}
+	}
+}
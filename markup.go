@@ -0,0 +1,241 @@
+package wordnik
+
+import (
+	"encoding/xml"
+	"html"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// NodeKind identifies the kind of a Node in Markup.
+type NodeKind int
+
+const (
+	// TextNode is a run of plain text, held in Node.Text.
+	TextNode NodeKind = iota
+
+	// XrefNode is a cross-reference to another word, held in Node.Word, from
+	// an <xref> or <internalXref> tag.
+	XrefNode
+
+	// EmphasisNode is emphasized text, from an <em> or <i> tag.
+	EmphasisNode
+
+	// StrongNode is strongly emphasized text, from a <strong> or <b> tag.
+	StrongNode
+
+	// SpanNode is any other tagged text, such as <spn>, with the tag name in
+	// Node.Tag.
+	SpanNode
+)
+
+// Node is an element of Markup. Nodes other than TextNodes hold their content
+// in Children.
+type Node struct {
+	Kind NodeKind
+
+	// Tag is the name of the tag the node was parsed from, if any.
+	Tag string
+
+	// Text is the content of a TextNode.
+	Text string
+
+	// Word is the target of an XrefNode.
+	Word string
+
+	Children []Node
+}
+
+// Markup is definition text parsed into a tree of Nodes, as returned by
+// ParseMarkup.
+type Markup []Node
+
+// wordnikWordURL is the page cross-references link to in Markdown and HTML.
+const wordnikWordURL = "https://www.wordnik.com/words/"
+
+// ParseMarkup parses text containing the closed set of tags returned with
+// IncludeTags(true), such as "A <xref>cat</xref>, <em>especially</em> one".
+// Malformed tags are handled leniently: unclosed tags extend to the end of the
+// text, an end tag closes the innermost open tag of the same name along with
+// any left open inside it, and other stray end tags close the innermost tag,
+// or are skipped outside any tag. Text which can't be parsed at all is
+// returned as a single TextNode with any tags left in place.
+func ParseMarkup(text string) Markup {
+	nodes, err := parseMarkup(text)
+	if err != nil {
+		return Markup{{Kind: TextNode, Text: text}}
+	}
+	return nodes
+}
+
+// markupFrame is an element still open while parsing Markup.
+type markupFrame struct {
+	start xml.StartElement
+	nodes Markup
+}
+
+// parseMarkup is like ParseMarkup, but returns an error for text which can't
+// be parsed. Tokens are read raw, so that the decoder doesn't reject
+// mismatched tags, and matched up here instead.
+func parseMarkup(text string) (Markup, error) {
+	decoder := xml.NewDecoder(strings.NewReader(text))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	// stack[0] holds the top-level nodes, and never closes.
+	stack := []*markupFrame{{}}
+	closeTo := func(depth int) {
+		for len(stack) > depth {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			parent := stack[len(stack)-1]
+			parent.nodes = append(parent.nodes, newElement(top.start, top.nodes))
+		}
+	}
+
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			closeTo(1)
+			return stack[0].nodes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		top := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.CharData:
+			if n := len(top.nodes); n > 0 && top.nodes[n-1].Kind == TextNode {
+				top.nodes[n-1].Text += string(t)
+			} else {
+				top.nodes = append(top.nodes, Node{Kind: TextNode, Text: string(t)})
+			}
+
+		case xml.StartElement:
+			stack = append(stack, &markupFrame{start: t.Copy()})
+
+		case xml.EndElement:
+			depth := len(stack) - 1
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].start.Name.Local == t.Name.Local {
+					depth = i
+					break
+				}
+			}
+			if depth > 0 {
+				closeTo(depth)
+			}
+		}
+	}
+}
+
+// newElement returns the Node for an element with the given children.
+func newElement(start xml.StartElement, children Markup) Node {
+	node := Node{Kind: SpanNode, Tag: start.Name.Local, Children: children}
+
+	switch start.Name.Local {
+	case "xref", "internalXref":
+		node.Kind = XrefNode
+		node.Word = children.Text()
+		for _, attr := range start.Attr {
+			if attr.Name.Local == "urlencoded" {
+				if word, err := url.QueryUnescape(attr.Value); err == nil && word != "" {
+					node.Word = word
+				}
+			}
+		}
+	case "em", "i":
+		node.Kind = EmphasisNode
+	case "strong", "b":
+		node.Kind = StrongNode
+	}
+
+	return node
+}
+
+// Markup parses the definition's Text. See ParseMarkup.
+func (d Definition) Markup() Markup {
+	return ParseMarkup(d.Text)
+}
+
+// Xrefs returns the words cross-referenced in m, in order.
+func (m Markup) Xrefs() []string {
+	var words []string
+	for _, node := range m {
+		if node.Kind == XrefNode {
+			words = append(words, node.Word)
+		}
+		words = append(words, Markup(node.Children).Xrefs()...)
+	}
+	return words
+}
+
+// Text renders m as plain text, dropping all tags.
+func (m Markup) Text() string {
+	var b strings.Builder
+	for _, node := range m {
+		if node.Kind == TextNode {
+			b.WriteString(node.Text)
+		} else {
+			b.WriteString(Markup(node.Children).Text())
+		}
+	}
+	return b.String()
+}
+
+// markdownEscaper escapes characters with special meaning in Markdown.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+)
+
+// Markdown renders m as Markdown. Emphasis becomes *text*, strong emphasis
+// **text**, and cross-references link to the word's page on wordnik.com.
+// Other tags are dropped.
+func (m Markup) Markdown() string {
+	var b strings.Builder
+	for _, node := range m {
+		children := Markup(node.Children).Markdown()
+
+		switch node.Kind {
+		case TextNode:
+			b.WriteString(markdownEscaper.Replace(node.Text))
+		case XrefNode:
+			b.WriteString("[" + children + "](" + wordnikWordURL + url.PathEscape(node.Word) + ")")
+		case EmphasisNode:
+			b.WriteString("*" + children + "*")
+		case StrongNode:
+			b.WriteString("**" + children + "**")
+		default:
+			b.WriteString(children)
+		}
+	}
+	return b.String()
+}
+
+// HTML renders m as HTML. Emphasis becomes <em>, strong emphasis <strong>,
+// cross-references link to the word's page on wordnik.com, and other tags
+// become a <span> with the tag name as its class, e.g. <span class="spn">.
+// Text is escaped.
+func (m Markup) HTML() string {
+	var b strings.Builder
+	for _, node := range m {
+		children := Markup(node.Children).HTML()
+
+		switch node.Kind {
+		case TextNode:
+			b.WriteString(html.EscapeString(node.Text))
+		case XrefNode:
+			href := wordnikWordURL + url.PathEscape(node.Word)
+			b.WriteString(`<a href="` + html.EscapeString(href) + `">` + children + "</a>")
+		case EmphasisNode:
+			b.WriteString("<em>" + children + "</em>")
+		case StrongNode:
+			b.WriteString("<strong>" + children + "</strong>")
+		default:
+			b.WriteString(`<span class="` + html.EscapeString(node.Tag) + `">` + children + "</span>")
+		}
+	}
+	return b.String()
+}
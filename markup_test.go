@@ -0,0 +1,83 @@
+package wordnik
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMarkup(t *testing.T) {
+	m := ParseMarkup(`A <xref>cat</xref> or <internalXref urlencoded="big%20dog">dogs</internalXref>, <em>especially <spn>one</spn></em> &amp; more.`)
+
+	expected := Markup{
+		{Kind: TextNode, Text: "A "},
+		{Kind: XrefNode, Tag: "xref", Word: "cat", Children: []Node{{Kind: TextNode, Text: "cat"}}},
+		{Kind: TextNode, Text: " or "},
+		{Kind: XrefNode, Tag: "internalXref", Word: "big dog", Children: []Node{{Kind: TextNode, Text: "dogs"}}},
+		{Kind: TextNode, Text: ", "},
+		{Kind: EmphasisNode, Tag: "em", Children: []Node{
+			{Kind: TextNode, Text: "especially "},
+			{Kind: SpanNode, Tag: "spn", Children: []Node{{Kind: TextNode, Text: "one"}}},
+		}},
+		{Kind: TextNode, Text: " & more."},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("got %+v\nexpected %+v", m, expected)
+	}
+
+	if xrefs := m.Xrefs(); !reflect.DeepEqual(xrefs, []string{"cat", "big dog"}) {
+		t.Errorf("got xrefs %v", xrefs)
+	}
+}
+
+func TestParseMarkupMalformed(t *testing.T) {
+	cases := map[string]string{
+		"unclosed <em>emphasis":      "unclosed emphasis",
+		"mismatched <em>tags</xref>": "mismatched tags",
+		"A </em> cat and dog":        "A  cat and dog",
+		"<em>a</b> tail":             "a tail",
+		"<em>a <b>b</em> tail":       "a b tail",
+		"1 < 2 <xref>":               "1 < 2 <xref>",
+		"":                           "",
+	}
+
+	for text, expected := range cases {
+		if got := ParseMarkup(text).Text(); got != expected {
+			t.Errorf("%q: got text %q, expected %q", text, got, expected)
+		}
+	}
+
+	m := ParseMarkup("unclosed <em>emphasis")
+	if len(m) != 2 || m[1].Kind != EmphasisNode {
+		t.Errorf("expected unclosed tag to extend to the end, got %+v", m)
+	}
+
+	m = ParseMarkup("<em>a <b>b</em> tail")
+	if len(m) != 2 || m[0].Kind != EmphasisNode || m[0].Children[1].Kind != StrongNode || m[1].Text != " tail" {
+		t.Errorf("expected end tag to close the tags left open inside it, got %+v", m)
+	}
+}
+
+func TestMarkupRenderers(t *testing.T) {
+	m := ParseMarkup(`See <xref>tom cat</xref>; <strong>*not*</strong> <em>a</em> <spn>dog</spn> & <b>that</b>.`)
+
+	if text := m.Text(); text != "See tom cat; *not* a dog & that." {
+		t.Errorf("got text %q", text)
+	}
+
+	markdown := `See [tom cat](https://www.wordnik.com/words/tom%20cat); **\*not\*** *a* dog & **that**.`
+	if got := m.Markdown(); got != markdown {
+		t.Errorf("got markdown %q, expected %q", got, markdown)
+	}
+
+	html := `See <a href="https://www.wordnik.com/words/tom%20cat">tom cat</a>; <strong>*not*</strong> <em>a</em> <span class="spn">dog</span> &amp; <strong>that</strong>.`
+	if got := m.HTML(); got != html {
+		t.Errorf("got html %q, expected %q", got, html)
+	}
+}
+
+func TestDefinitionMarkup(t *testing.T) {
+	def := Definition{Text: "A small <xref>feline</xref>."}
+	if xrefs := def.Markup().Xrefs(); len(xrefs) != 1 || xrefs[0] != "feline" {
+		t.Errorf("got xrefs %v", xrefs)
+	}
+}
@@ -0,0 +1,43 @@
+package wordnik
+
+import "net/http"
+
+// Doer sends an HTTP request and returns its response. *http.Client
+// implements Doer.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts an ordinary function to the Doer interface.
+type DoerFunc func(*http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer with additional behavior, such as adding headers,
+// signing or auditing requests, or injecting faults in tests. Middleware sees
+// every attempt of every request, including retries.
+type Middleware func(next Doer) Doer
+
+// Use appends middleware to the Client's chain. The first middleware added is
+// the outermost, and the Client's http.Client is always the innermost Doer.
+// It should be called before the Client is used concurrently.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// doer returns the Client's http.Client wrapped in its middleware chain. The
+// logger, if any, sits innermost so that it sees requests as they are sent.
+func (c *Client) doer() Doer {
+	var d Doer = c.client
+	if c.logger != nil {
+		d = loggingDoer{next: d, logger: c.logger, baseURL: c.baseURL.Path}
+	}
+
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	return d
+}
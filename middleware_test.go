@@ -0,0 +1,93 @@
+package wordnik
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Helper function for testing which returns middleware that records name in
+// order before and after calling the next Doer.
+func recordingMiddleware(name string, order *[]string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			*order = append(*order, name+" before")
+			res, err := next.Do(req)
+			*order = append(*order, name+" after")
+			return res, err
+		})
+	}
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	var order []string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, "server "+r.Header.Get("X-Injected"))
+		w.Write([]byte(`{}`))
+	})
+
+	inject := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Injected", "yes")
+			return next.Do(req)
+		})
+	}
+
+	cl.Use(recordingMiddleware("outer", &order))
+	WithMiddleware(recordingMiddleware("inner", &order), inject)(cl)
+
+	if _, err := cl.GetWord("cat"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "outer before,inner before,server yes,inner after,outer after"
+	if strings.Join(order, ",") != expected {
+		t.Errorf("got order %q, expected %q", strings.Join(order, ","), expected)
+	}
+}
+
+func TestMiddlewareFaultInjection(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected request not to reach the server")
+	})
+
+	fault := errors.New("injected fault")
+	cl.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fault
+		})
+	})
+
+	if _, err := cl.GetWord("cat"); !errors.Is(err, fault) {
+		t.Errorf("expected injected fault, got %v", err)
+	}
+}
+
+func TestMiddlewareSyntheticErrorResponse(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected request not to reach the server")
+	})
+
+	// The synthetic response has no Request set.
+	cl.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"message":"injected outage"}`)),
+			}, nil
+		})
+	})
+
+	_, err := cl.GetWord("cat")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusServiceUnavailable || apiErr.Method != "GET" || apiErr.Endpoint != "word.json/cat" {
+		t.Errorf("unexpected APIError %+v", apiErr)
+	}
+}
@@ -0,0 +1,256 @@
+package wordnik
+
+import (
+	"context"
+	"iter"
+	"net/url"
+)
+
+// defaultPageSize is the number of items a Pager requests at a time, unless
+// set by PageSize or a Limit option.
+const defaultPageSize = 50
+
+// fetchPage requests limit items starting at skip, returning them along with
+// the total number of results, if the endpoint reports one.
+type fetchPage[T any] func(ctx context.Context, skip, limit int64) ([]T, int64, error)
+
+// A Pager iterates over the results of a paginated endpoint, such as
+// SearchWords, requesting further pages as they are needed. Pagers are created
+// by Client methods such as SearchWordsIter, and are not safe for concurrent
+// use.
+//
+// Use Next, Item and Err to step through results:
+//
+//	it := cl.SearchWordsIter("fru").MaxItems(100)
+//	for it.Next() {
+//		fmt.Println(it.Item().Word)
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+//
+// or range over All. Iteration can be stopped early at any point without
+// requesting further pages.
+type Pager[T any] struct {
+	ctx      context.Context
+	fetch    fetchPage[T]
+	hasTotal bool
+
+	skip     int64
+	pageSize int64
+	maxItems int64
+
+	page    []T
+	limit   int64
+	index   int
+	seen    int64
+	total   int64
+	item    T
+	err     error
+	done    bool
+	started bool
+}
+
+// newPager returns a Pager which fetches pages with fetch. The Skip and Limit
+// options, if given, set its starting offset and page size.
+func newPager[T any](ctx context.Context, fetch fetchPage[T], hasTotal bool, queryOptions []QueryOption) *Pager[T] {
+	p := &Pager[T]{ctx: ctx, fetch: fetch, hasTotal: hasTotal, pageSize: defaultPageSize, maxItems: -1}
+
+	q := url.Values{}
+	for _, option := range queryOptions {
+		option(&q)
+	}
+	if n, ok := intValue(q, "skip"); ok && n > 0 {
+		p.skip = n
+	}
+	if n, ok := intValue(q, "limit"); ok && n > 0 {
+		p.pageSize = n
+	}
+
+	return p
+}
+
+// PageSize sets the number of items requested at a time. It should be called
+// before iteration starts, and returns the Pager for chaining.
+func (p *Pager[T]) PageSize(n int64) *Pager[T] {
+	if n > 0 && !p.started {
+		p.pageSize = n
+	}
+	return p
+}
+
+// MaxItems sets the maximum number of items to iterate over, or no maximum if
+// n is negative, which is the default. It should be called before iteration
+// starts, and returns the Pager for chaining.
+func (p *Pager[T]) MaxItems(n int64) *Pager[T] {
+	if !p.started {
+		p.maxItems = n
+	}
+	return p
+}
+
+// Next advances to the next item, requesting another page if needed. It
+// returns false when the results are exhausted, MaxItems is reached, or an
+// error occurs, which is then returned by Err.
+func (p *Pager[T]) Next() bool {
+	p.started = true
+	if p.done {
+		return false
+	}
+
+	if p.maxItems >= 0 && p.seen >= p.maxItems {
+		p.done = true
+		return false
+	}
+
+	if p.index >= len(p.page) {
+		if !p.nextPage() {
+			p.done = true
+			return false
+		}
+	}
+
+	p.item = p.page[p.index]
+	p.index++
+	p.seen++
+	return true
+}
+
+// nextPage requests the page following the current one, reporting whether it
+// holds any items.
+func (p *Pager[T]) nextPage() bool {
+	if p.page != nil {
+		if int64(len(p.page)) < p.limit && !p.hasTotal {
+			return false
+		}
+		if p.hasTotal && p.skip >= p.total {
+			return false
+		}
+	}
+
+	p.limit = p.nextLimit()
+	page, total, err := p.fetch(p.ctx, p.skip, p.limit)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.page, p.index, p.total = page, 0, total
+	p.skip += int64(len(page))
+	return len(page) > 0
+}
+
+// nextLimit returns the number of items to request for the next page, which
+// is the page size unless fewer remain before MaxItems.
+func (p *Pager[T]) nextLimit() int64 {
+	if p.maxItems >= 0 && p.maxItems-p.seen < p.pageSize {
+		return p.maxItems - p.seen
+	}
+	return p.pageSize
+}
+
+// Item returns the current item. It is only valid after Next returns true.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error which stopped iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Total returns the total number of results reported by the API with the most
+// recent page, or 0 if the endpoint doesn't report one, as with GetExamples.
+func (p *Pager[T]) Total() int64 {
+	return p.total
+}
+
+// All returns an iterator over the remaining items. If an error occurs, it is
+// yielded with a zero item and iteration stops. Breaking out of a range loop
+// over All stops iteration without requesting further pages.
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+
+		if p.err != nil {
+			var zero T
+			yield(zero, p.err)
+		}
+	}
+}
+
+// Collect returns the remaining items, stopping at the first error.
+func (p *Pager[T]) Collect() ([]T, error) {
+	var items []T
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.err
+}
+
+// pageOptions returns queryOptions followed by the Skip and Limit options for
+// a page, without modifying queryOptions.
+func pageOptions(queryOptions []QueryOption, skip, limit int64) []QueryOption {
+	options := make([]QueryOption, 0, len(queryOptions)+2)
+	options = append(options, queryOptions...)
+	return append(options, Skip(skip), Limit(limit))
+}
+
+// SearchWordsIter returns a Pager over the results of a word search, which
+// requests pages from SearchWords as needed. The Skip and Limit options set
+// the starting offset and page size.
+func (c *Client) SearchWordsIter(query string, queryOptions ...QueryOption) *Pager[WordSearchResult] {
+	return c.SearchWordsIterContext(context.Background(), query, queryOptions...)
+}
+
+// SearchWordsIterContext is like SearchWordsIter, but carries a context for
+// cancellation and deadlines.
+func (c *Client) SearchWordsIterContext(ctx context.Context, query string, queryOptions ...QueryOption) *Pager[WordSearchResult] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]WordSearchResult, int64, error) {
+		results, err := c.SearchWordsContext(ctx, query, pageOptions(queryOptions, skip, limit)...)
+		return results.SearchResults, results.TotalResults, err
+	}
+
+	return newPager(ctx, fetch, true, queryOptions)
+}
+
+// ReverseDictionaryIter returns a Pager over the results of a reverse
+// dictionary search, which requests pages from ReverseDictionary as needed.
+// The Skip and Limit options set the starting offset and page size.
+func (c *Client) ReverseDictionaryIter(query string, queryOptions ...QueryOption) *Pager[Definition] {
+	return c.ReverseDictionaryIterContext(context.Background(), query, queryOptions...)
+}
+
+// ReverseDictionaryIterContext is like ReverseDictionaryIter, but carries a
+// context for cancellation and deadlines.
+func (c *Client) ReverseDictionaryIterContext(ctx context.Context, query string, queryOptions ...QueryOption) *Pager[Definition] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]Definition, int64, error) {
+		results, err := c.ReverseDictionaryContext(ctx, query, pageOptions(queryOptions, skip, limit)...)
+		return results.Results, results.TotalResults, err
+	}
+
+	return newPager(ctx, fetch, true, queryOptions)
+}
+
+// GetExamplesIter returns a Pager over the examples for a word, which requests
+// pages from GetExamples as needed. The Skip and Limit options set the
+// starting offset and page size. Since the API doesn't report a total number
+// of examples, iteration stops at the first page which isn't full.
+func (c *Client) GetExamplesIter(word string, queryOptions ...QueryOption) *Pager[Example] {
+	return c.GetExamplesIterContext(context.Background(), word, queryOptions...)
+}
+
+// GetExamplesIterContext is like GetExamplesIter, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetExamplesIterContext(ctx context.Context, word string, queryOptions ...QueryOption) *Pager[Example] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]Example, int64, error) {
+		results, err := c.GetExamplesContext(ctx, word, pageOptions(queryOptions, skip, limit)...)
+		return results.Examples, 0, err
+	}
+
+	return newPager(ctx, fetch, false, queryOptions)
+}
@@ -0,0 +1,136 @@
+package wordnik
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagingClient returns a Client whose search and example endpoints page
+// through n numbered results, counting the requests made in requests.
+func newPagingClient(t *testing.T, n int, requests *int32) *Client {
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var results []WordSearchResult
+		var examples []Example
+		for i := skip; i < n && i < skip+limit; i++ {
+			results = append(results, WordSearchResult{Word: "w" + strconv.Itoa(i)})
+			examples = append(examples, Example{ID: int64(i)})
+		}
+
+		if r.URL.Path == "/v4/word.json/cat/examples" {
+			json.NewEncoder(w).Encode(ExampleSearchResults{Examples: examples})
+			return
+		}
+		json.NewEncoder(w).Encode(WordSearchResults{SearchResults: results, TotalResults: int64(n)})
+	})
+}
+
+func TestSearchWordsIter(t *testing.T) {
+	var requests int32
+	cl := newPagingClient(t, 7, &requests)
+
+	it := cl.SearchWordsIter("w", Skip(1)).PageSize(3)
+	var words []string
+	for it.Next() {
+		words = append(words, it.Item().Word)
+	}
+
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+
+	expected := []string{"w1", "w2", "w3", "w4", "w5", "w6"}
+	if !reflect.DeepEqual(words, expected) {
+		t.Errorf("got %v, expected %v", words, expected)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+	if it.Total() != 7 {
+		t.Errorf("expected total of 7, got %d", it.Total())
+	}
+}
+
+func TestPagerMaxItems(t *testing.T) {
+	var requests int32
+	cl := newPagingClient(t, 100, &requests)
+
+	var words []string
+	for result, err := range cl.SearchWordsIter("w", Limit(4)).MaxItems(6).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		words = append(words, result.Word)
+	}
+
+	if len(words) != 6 || words[5] != "w5" {
+		t.Errorf("got %v", words)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestPagerEarlyStop(t *testing.T) {
+	var requests int32
+	cl := newPagingClient(t, 100, &requests)
+
+	count := 0
+	for range cl.SearchWordsIter("w").PageSize(5).All() {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestGetExamplesIter(t *testing.T) {
+	var requests int32
+	cl := newPagingClient(t, 12, &requests)
+
+	it := cl.GetExamplesIter("cat").PageSize(5)
+	count := 0
+	for it.Next() {
+		if it.Item().ID != int64(count) {
+			t.Errorf("got example %d, expected %d", it.Item().ID, count)
+		}
+		count++
+	}
+
+	if count != 12 || it.Err() != nil {
+		t.Errorf("got %d examples, err %v", count, it.Err())
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "bad request"}`))
+	})
+
+	var gotErr error
+	for _, err := range cl.ReverseDictionaryIter("cat").All() {
+		gotErr = err
+	}
+
+	var apiErr *APIError
+	if !errors.As(gotErr, &apiErr) {
+		t.Errorf("expected APIError, got %v", gotErr)
+	}
+}
@@ -0,0 +1,150 @@
+package wordnik
+
+import (
+	"log/slog"
+	"net/url"
+	"sort"
+)
+
+// OptionPolicy determines how a Client treats query options which don't apply
+// to the endpoint they're passed to, such as TypeFormat given to SearchWords.
+type OptionPolicy int
+
+const (
+	// AllowInapplicableOptions sends inapplicable options to the API, which
+	// ignores them. This is the default.
+	AllowInapplicableOptions OptionPolicy = iota
+
+	// WarnInapplicableOptions sends inapplicable options, logging a warning
+	// for each to the Client's logger, or slog.Default if none is set.
+	WarnInapplicableOptions
+
+	// RejectInapplicableOptions fails calls given inapplicable options with a
+	// ValidationError, before any request is sent.
+	RejectInapplicableOptions
+)
+
+// endpointParams lists the optional query parameters accepted by each
+// endpoint which takes QueryOptions.
+var endpointParams = map[string][]string{
+	"GetExamples":      {"includeDuplicates", "useCanonical", "skip", "limit"},
+	"GetWord":          {"useCanonical", "includeSuggestions"},
+	"GetDefinitions":   {"limit", "partOfSpeech", "includeRelated", "sourceDictionaries", "useCanonical", "includeTags"},
+	"TopExample":       {"useCanonical"},
+	"GetRelatedWords":  {"useCanonical", "relationshipTypes", "limitRelationshipType"},
+	"Pronunciations":   {"useCanonical", "sourceDictionary", "typeFormat", "limit"},
+	"Hyphenation":      {"useCanonical", "sourceDictionary", "limit"},
+	"GetWordFrequency": {"useCanonical", "startYear", "endYear"},
+	"GetPhrases":       {"limit", "wlmi", "useCanonical"},
+	"GetEtymologies":   {"useCanonical"},
+	"GetAudio":         {"useCanonical", "limit"},
+
+	"SearchWords": {
+		"caseSensitive", "includePartOfSpeech", "excludePartOfSpeech",
+		"minCorpusCount", "maxCorpusCount", "minDictionaryCount", "maxDictionaryCount",
+		"minLength", "maxLength", "skip", "limit",
+	},
+	"ReverseDictionary": {
+		"findSenseForWord", "includeSourceDictionaries", "excludeSourceDictionaries",
+		"includePartOfSpeech", "excludePartOfSpeech", "minCorpusCount", "maxCorpusCount",
+		"minLength", "maxLength", "expandTerms", "includeTags", "sortBy", "sortOrder",
+		"skip", "limit",
+	},
+	"RandomWords": {
+		"hasDictionaryDef", "includePartOfSpeech", "excludePartOfSpeech",
+		"minCorpusCount", "maxCorpusCount", "minDictionaryCount", "maxDictionaryCount",
+		"minLength", "maxLength", "sortBy", "sortOrder", "limit",
+	},
+	"RandomWord": {
+		"hasDictionaryDef", "includePartOfSpeech", "excludePartOfSpeech",
+		"minCorpusCount", "maxCorpusCount", "minDictionaryCount", "maxDictionaryCount",
+		"minLength", "maxLength",
+	},
+
+	"GetWordListsForUser": {"skip", "limit"},
+	"GetWordListWords":    {"sortBy", "sortOrder", "skip", "limit"},
+}
+
+// AcceptedParams returns the optional query parameters accepted by endpoint,
+// named after its Client method, e.g. "GetDefinitions", in alphabetical
+// order. It returns false for endpoints which don't take QueryOptions.
+func AcceptedParams(endpoint string) ([]string, bool) {
+	params, ok := endpointParams[endpoint]
+	if !ok {
+		return nil, false
+	}
+
+	sorted := append([]string(nil), params...)
+	sort.Strings(sorted)
+	return sorted, true
+}
+
+// OptionEndpoints returns the names of the endpoints which take QueryOptions,
+// in alphabetical order.
+func OptionEndpoints() []string {
+	endpoints := make([]string, 0, len(endpointParams))
+	for endpoint := range endpointParams {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// SetOptionPolicy sets how the Client treats query options which don't apply
+// to an endpoint. It should be called before the Client is used concurrently.
+func (c *Client) SetOptionPolicy(policy OptionPolicy) {
+	c.optionPolicy = policy
+}
+
+// inapplicableParams returns the parameters in vals which endpoint doesn't
+// accept, in alphabetical order. Parameters in defaults, set by the endpoint
+// itself, are always accepted.
+func inapplicableParams(endpoint string, vals url.Values, defaults map[string]bool) []string {
+	params, ok := endpointParams[endpoint]
+	if !ok {
+		return nil
+	}
+
+	accepted := map[string]bool{}
+	for _, param := range params {
+		accepted[param] = true
+	}
+
+	var inapplicable []string
+	for param := range vals {
+		if !accepted[param] && !defaults[param] && param != strictKey && param != invalidKey {
+			inapplicable = append(inapplicable, param)
+		}
+	}
+
+	sort.Strings(inapplicable)
+	return inapplicable
+}
+
+// checkApplicability applies the Client's OptionPolicy to the parameters in
+// vals which endpoint doesn't accept, returning a problem for each one which
+// should be rejected.
+func (c *Client) checkApplicability(endpoint string, vals url.Values, defaults map[string]bool) []string {
+	if c.optionPolicy == AllowInapplicableOptions {
+		return nil
+	}
+
+	var problems []string
+	for _, param := range inapplicableParams(endpoint, vals, defaults) {
+		if c.optionPolicy == RejectInapplicableOptions {
+			problems = append(problems, param+" is not accepted by "+endpoint)
+			continue
+		}
+
+		logger := c.logger
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.Warn("wordnik: option not accepted by endpoint",
+			slog.String("endpoint", endpoint),
+			slog.String("param", param))
+	}
+
+	return problems
+}
@@ -0,0 +1,162 @@
+package wordnik
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAcceptedParams(t *testing.T) {
+	params, ok := AcceptedParams("Pronunciations")
+	if !ok {
+		t.Fatal("expected Pronunciations to declare its parameters")
+	}
+
+	expected := []string{"limit", "sourceDictionary", "typeFormat", "useCanonical"}
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("got %v, expected %v", params, expected)
+	}
+
+	params[0] = "modified"
+	if again, _ := AcceptedParams("Pronunciations"); again[0] != "limit" {
+		t.Error("expected AcceptedParams to return a copy")
+	}
+
+	if _, ok := AcceptedParams("GetUser"); ok {
+		t.Error("expected GetUser to take no query options")
+	}
+}
+
+func TestOptionEndpoints(t *testing.T) {
+	endpoints := OptionEndpoints()
+	if len(endpoints) != len(endpointParams) {
+		t.Errorf("expected %d endpoints, got %d", len(endpointParams), len(endpoints))
+	}
+
+	for i := 1; i < len(endpoints); i++ {
+		if endpoints[i-1] >= endpoints[i] {
+			t.Errorf("endpoints not sorted: %v", endpoints)
+		}
+	}
+}
+
+// optionCalls calls every endpoint which takes QueryOptions with options.
+var optionCalls = map[string]func(*Client, ...QueryOption) error{
+	"GetExamples":      func(c *Client, o ...QueryOption) error { _, err := c.GetExamples("cat", o...); return err },
+	"GetWord":          func(c *Client, o ...QueryOption) error { _, err := c.GetWord("cat", o...); return err },
+	"GetDefinitions":   func(c *Client, o ...QueryOption) error { _, err := c.GetDefinitions("cat", o...); return err },
+	"TopExample":       func(c *Client, o ...QueryOption) error { _, err := c.TopExample("cat", o...); return err },
+	"GetRelatedWords":  func(c *Client, o ...QueryOption) error { _, err := c.GetRelatedWords("cat", o...); return err },
+	"Pronunciations":   func(c *Client, o ...QueryOption) error { _, err := c.Pronunciations("cat", o...); return err },
+	"Hyphenation":      func(c *Client, o ...QueryOption) error { _, err := c.Hyphenation("cat", o...); return err },
+	"GetWordFrequency": func(c *Client, o ...QueryOption) error { _, err := c.GetWordFrequency("cat", o...); return err },
+	"GetPhrases":       func(c *Client, o ...QueryOption) error { _, err := c.GetPhrases("cat", o...); return err },
+	"GetEtymologies":   func(c *Client, o ...QueryOption) error { _, err := c.GetEtymologies("cat", o...); return err },
+	"GetAudio":         func(c *Client, o ...QueryOption) error { _, err := c.GetAudio("cat", o...); return err },
+
+	"SearchWords":       func(c *Client, o ...QueryOption) error { _, err := c.SearchWords("cat", o...); return err },
+	"ReverseDictionary": func(c *Client, o ...QueryOption) error { _, err := c.ReverseDictionary("cat", o...); return err },
+	"RandomWords":       func(c *Client, o ...QueryOption) error { _, err := c.RandomWords(o...); return err },
+	"RandomWord":        func(c *Client, o ...QueryOption) error { _, err := c.RandomWord(o...); return err },
+
+	"GetWordListsForUser": func(c *Client, o ...QueryOption) error { _, err := c.GetWordListsForUser("token", o...); return err },
+	"GetWordListWords": func(c *Client, o ...QueryOption) error {
+		_, err := c.GetWordListWords("token", "list", o...)
+		return err
+	},
+}
+
+func TestOptionPolicyRejectsOnlyInapplicableOptions(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`null`))
+	})
+	cl.SetOptionPolicy(RejectInapplicableOptions)
+
+	for _, endpoint := range OptionEndpoints() {
+		call, ok := optionCalls[endpoint]
+		if !ok {
+			t.Errorf("no test call for %s", endpoint)
+			continue
+		}
+
+		if err := call(cl); err != nil {
+			t.Errorf("%s: unexpected error with default options: %v", endpoint, err)
+		}
+
+		params, _ := AcceptedParams(endpoint)
+		for _, param := range params {
+			param := param
+			option := func(q *url.Values) { q.Set(param, "1") }
+			if err := call(cl, option); err != nil {
+				t.Errorf("%s: unexpected error for accepted param %s: %v", endpoint, param, err)
+			}
+		}
+	}
+}
+
+func TestOptionPolicyReject(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{}`))
+	})
+	cl.SetOptionPolicy(RejectInapplicableOptions)
+
+	_, err := cl.SearchWords("cat", TypeFormat("IPA"), StartYear(2000), Limit(5))
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+
+	expected := []string{"startYear is not accepted by SearchWords", "typeFormat is not accepted by SearchWords"}
+	if !reflect.DeepEqual(valErr.Problems, expected) {
+		t.Errorf("got problems %q, expected %q", valErr.Problems, expected)
+	}
+	if calls != 0 {
+		t.Errorf("expected no request to be sent, got %d", calls)
+	}
+}
+
+func TestOptionPolicyWarn(t *testing.T) {
+	var typeFormat string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		typeFormat = r.URL.Query().Get("typeFormat")
+		w.Write([]byte(`[]`))
+	})
+
+	var logs bytes.Buffer
+	cl.SetLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	cl.SetOptionPolicy(WarnInapplicableOptions)
+
+	if _, err := cl.GetAudio("cat", TypeFormat("IPA")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if typeFormat != "IPA" {
+		t.Errorf("expected inapplicable option to be sent, got typeFormat=%q", typeFormat)
+	}
+	if !strings.Contains(logs.String(), "level=WARN") || !strings.Contains(logs.String(), "endpoint=GetAudio param=typeFormat") {
+		t.Errorf("expected warning to be logged, got:\n%s", logs.String())
+	}
+}
+
+func TestOptionPolicyAllowByDefault(t *testing.T) {
+	var startYear string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		startYear = r.URL.Query().Get("startYear")
+		w.Write([]byte(`[]`))
+	})
+
+	if _, err := cl.GetDefinitions("cat", StartYear(2000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if startYear != "2000" {
+		t.Errorf("expected startYear to be sent, got %q", startYear)
+	}
+}
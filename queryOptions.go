@@ -7,43 +7,43 @@ import (
 )
 
 var (
-	validPartOfSpeech = map[string]bool{
-		"noun":                  true,
-		"adjective":             true,
-		"verb":                  true,
-		"adverb":                true,
-		"interjection":          true,
-		"pronoun":               true,
-		"preposition":           true,
-		"abbreviation":          true,
-		"affix":                 true,
-		"article":               true,
-		"auxiliary-verb":        true,
-		"conjunction":           true,
-		"definite-article":      true,
-		"family-name":           true,
-		"given-name":            true,
-		"idiom":                 true,
-		"imperative":            true,
-		"noun-plural":           true,
-		"noun-posessive":        true,
-		"past-participle":       true,
-		"phrasal-prefix":        true,
-		"proper-noun":           true,
-		"proper-noun-plural":    true,
-		"proper-noun-posessive": true,
-		"suffix":                true,
-		"verb-intransitive":     true,
-		"verb-transitive":       true,
-	}
-
-	validSourceDictionaries = map[string]bool{
-		"all":        true,
-		"ahd":        true,
-		"century":    true,
-		"wiktionary": true,
-		"webster":    true,
-		"wordnet":    true,
+	validPartOfSpeech = map[PartOfSpeech]bool{
+		PartOfSpeechNoun:                true,
+		PartOfSpeechAdjective:           true,
+		PartOfSpeechVerb:                true,
+		PartOfSpeechAdverb:              true,
+		PartOfSpeechInterjection:        true,
+		PartOfSpeechPronoun:             true,
+		PartOfSpeechPreposition:         true,
+		PartOfSpeechAbbreviation:        true,
+		PartOfSpeechAffix:               true,
+		PartOfSpeechArticle:             true,
+		PartOfSpeechAuxiliaryVerb:       true,
+		PartOfSpeechConjunction:         true,
+		PartOfSpeechDefiniteArticle:     true,
+		PartOfSpeechFamilyName:          true,
+		PartOfSpeechGivenName:           true,
+		PartOfSpeechIdiom:               true,
+		PartOfSpeechImperative:          true,
+		PartOfSpeechNounPlural:          true,
+		PartOfSpeechNounPosessive:       true,
+		PartOfSpeechPastParticiple:      true,
+		PartOfSpeechPhrasalPrefix:       true,
+		PartOfSpeechProperNoun:          true,
+		PartOfSpeechProperNounPlural:    true,
+		PartOfSpeechProperNounPosessive: true,
+		PartOfSpeechSuffix:              true,
+		PartOfSpeechVerbIntransitive:    true,
+		PartOfSpeechVerbTransitive:      true,
+	}
+
+	validSourceDictionaries = map[SourceDictionary]bool{
+		SourceDictionaryAll:        true,
+		SourceDictionaryAHD:        true,
+		SourceDictionaryCentury:    true,
+		SourceDictionaryWiktionary: true,
+		SourceDictionaryWebster:    true,
+		SourceDictionaryWordnet:    true,
 	}
 
 	validSortCriteria = map[string]bool{
@@ -63,30 +63,30 @@ var (
 		"desc": true,
 	}
 
-	validRelationshipTypes = map[string]bool{
-		"synonym":         true,
-		"antonym":         true,
-		"variant":         true,
-		"equivalent":      true,
-		"cross-reference": true,
-		"related-word":    true,
-		"rhyme":           true,
-		"form":            true,
-		"etymologically-related-term": true,
-		"hypernym":                    true,
-		"hyponym":                     true,
-		"inflected-form":              true,
-		"primary":                     true,
-		"same-context":                true,
-		"verb-form":                   true,
-		"verb-stem":                   true,
-	}
-
-	validTypeFormat = map[string]bool{
-		"ahd":               true,
-		"arpabet":           true,
-		"gcide-diacritical": true,
-		"IPA":               true,
+	validRelationshipTypes = map[RelationshipType]bool{
+		RelationshipTypeSynonym:                   true,
+		RelationshipTypeAntonym:                   true,
+		RelationshipTypeVariant:                   true,
+		RelationshipTypeEquivalent:                true,
+		RelationshipTypeCrossReference:            true,
+		RelationshipTypeRelatedWord:               true,
+		RelationshipTypeRhyme:                     true,
+		RelationshipTypeForm:                      true,
+		RelationshipTypeEtymologicallyRelatedTerm: true,
+		RelationshipTypeHypernym:                  true,
+		RelationshipTypeHyponym:                   true,
+		RelationshipTypeInflectedForm:             true,
+		RelationshipTypePrimary:                   true,
+		RelationshipTypeSameContext:               true,
+		RelationshipTypeVerbForm:                  true,
+		RelationshipTypeVerbStem:                  true,
+	}
+
+	validTypeFormat = map[PronunciationFormat]bool{
+		PronunciationFormatAHD:              true,
+		PronunciationFormatArpabet:          true,
+		PronunciationFormatGCIDEDiacritical: true,
+		PronunciationFormatIPA:              true,
 	}
 )
 
@@ -94,18 +94,30 @@ var (
 // by acting on url.Values pointers.
 type QueryOption func(*url.Values)
 
-func buildCommaSepQuery(items []string, validityMap map[string]bool) string {
+func buildCommaSepQuery[T ~string](items []T, validityMap map[T]bool) string {
 	var buffer bytes.Buffer
 
 	for _, item := range items {
 		if validityMap[item] {
-			buffer.WriteString(item)
+			buffer.WriteString(string(item))
 			buffer.WriteString(",")
 		}
 	}
 	return buffer.String()
 }
 
+// setCommaSepQuery sets param to the valid items, recording any invalid ones
+// for strict validation.
+func setCommaSepQuery[T ~string](q *url.Values, param string, items []T, validityMap map[T]bool) {
+	for _, item := range items {
+		if !validityMap[item] {
+			invalidOption(q, param, string(item))
+		}
+	}
+
+	q.Set(param, buildCommaSepQuery(items, validityMap))
+}
+
 // CaseSensitive sets the caseSensitive parameter based on boolean input.
 func CaseSensitive(b bool) QueryOption {
 	return func(q *url.Values) {
@@ -114,20 +126,18 @@ func CaseSensitive(b bool) QueryOption {
 }
 
 // IncludePartOfSpeech sets the includePartOfSpeech parameter based on variadic
-// string input.
-func IncludePartOfSpeech(parts ...string) QueryOption {
+// PartOfSpeech input.
+func IncludePartOfSpeech(parts ...PartOfSpeech) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(parts, validPartOfSpeech)
-		q.Set("includePartOfSpeech", value)
+		setCommaSepQuery(q, "includePartOfSpeech", parts, validPartOfSpeech)
 	}
 }
 
 // ExcludePartOfSpeech sets the excludePartOfSpeech parameter based on variadic
-// string input.
-func ExcludePartOfSpeech(parts ...string) QueryOption {
+// PartOfSpeech input.
+func ExcludePartOfSpeech(parts ...PartOfSpeech) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(parts, validPartOfSpeech)
-		q.Set("excludePartOfSpeech", value)
+		setCommaSepQuery(q, "excludePartOfSpeech", parts, validPartOfSpeech)
 	}
 }
 
@@ -195,20 +205,18 @@ func FindSenseForWord(sense string) QueryOption {
 }
 
 // IncludeSourceDictionaries sets the includeSourceDictionaries parameter based
-// on variadic string input.
-func IncludeSourceDictionaries(dicts ...string) QueryOption {
+// on variadic SourceDictionary input.
+func IncludeSourceDictionaries(dicts ...SourceDictionary) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(dicts, validSourceDictionaries)
-		q.Set("includeSourceDictionaries", value)
+		setCommaSepQuery(q, "includeSourceDictionaries", dicts, validSourceDictionaries)
 	}
 }
 
 // ExcludeSourceDictionaries sets the excludeSourceDictionaries parameter based
-// variadic string input.
-func ExcludeSourceDictionaries(dicts ...string) QueryOption {
+// on variadic SourceDictionary input.
+func ExcludeSourceDictionaries(dicts ...SourceDictionary) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(dicts, validSourceDictionaries)
-		q.Set("excludeSourceDictionaries", value)
+		setCommaSepQuery(q, "excludeSourceDictionaries", dicts, validSourceDictionaries)
 	}
 }
 
@@ -217,6 +225,8 @@ func ExpandTerms(term string) QueryOption {
 	return func(q *url.Values) {
 		if validExpandTerms[term] {
 			q.Set("expandTerms", term)
+		} else {
+			invalidOption(q, "expandTerms", term)
 		}
 	}
 }
@@ -234,6 +244,8 @@ func SortBy(sortCriteria string) QueryOption {
 	return func(q *url.Values) {
 		if validSortCriteria[sortCriteria] {
 			q.Set("sortBy", sortCriteria)
+		} else {
+			invalidOption(q, "sortBy", sortCriteria)
 		}
 	}
 }
@@ -243,6 +255,8 @@ func SortOrder(direction string) QueryOption {
 	return func(q *url.Values) {
 		if validSortOrder[direction] {
 			q.Set("sortOrder", direction)
+		} else {
+			invalidOption(q, "sortOrder", direction)
 		}
 	}
 }
@@ -282,38 +296,38 @@ func IncludeRelated(b bool) QueryOption {
 	}
 }
 
-// PartOfSpeech sets the partOfSpeech parameter based on variadic string input.
-func PartOfSpeech(parts ...string) QueryOption {
+// PartsOfSpeech sets the partOfSpeech parameter based on variadic PartOfSpeech
+// input.
+func PartsOfSpeech(parts ...PartOfSpeech) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(parts, validPartOfSpeech)
-		q.Set("partOfSpeech", value)
+		setCommaSepQuery(q, "partOfSpeech", parts, validPartOfSpeech)
 	}
 }
 
-// SourceDictionaries sets the sourceDictionaries parameter based on variadic string
-// input. Differs notably in effect from "includeSourceDictionaries" when used
-// in the context of Definitions. According to the API:  Source dictionary to
-// return definitions from. If 'all' is received, results are returned from all
-// sources. If multiple values are received (e.g. 'century,wiktionary'), results
-//  are returned from the first specified dictionary that has definitions. If
-// left blank, results are returned from the first dictionary that has
-// definitions. By default, dictionaries are searched in this order: ahd,
-// wiktionary, webster, century, wordnet
-func SourceDictionaries(dicts ...string) QueryOption {
+// SourceDictionaries sets the sourceDictionaries parameter based on variadic
+// SourceDictionary input. Differs notably in effect from
+// "includeSourceDictionaries" when used in the context of Definitions.
+// According to the API:  Source dictionary to return definitions from. If
+// 'all' is received, results are returned from all sources. If multiple values
+// are received (e.g. 'century,wiktionary'), results are returned from the first
+// specified dictionary that has definitions. If left blank, results are
+// returned from the first dictionary that has definitions. By default,
+// dictionaries are searched in this order: ahd, wiktionary, webster, century,
+// wordnet
+func SourceDictionaries(dicts ...SourceDictionary) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(dicts, validSourceDictionaries)
-		q.Set("sourceDictionaries", value)
+		setCommaSepQuery(q, "sourceDictionaries", dicts, validSourceDictionaries)
 	}
 }
 
-// RelationshipTypes sets the relationshipTypes parameter based on variadic string
-// input. This parameter works in conjunction with limitRelationshipType,
-// in that this list of relationship types is allowed but each type is limited
-// in how many examples it returns by limitRelationshipType.
-func RelationshipTypes(types ...string) QueryOption {
+// RelationshipTypes sets the relationshipTypes parameter based on variadic
+// RelationshipType input. This parameter works in conjunction with
+// limitRelationshipType, in that this list of relationship types is allowed but
+// each type is limited in how many examples it returns by
+// limitRelationshipType.
+func RelationshipTypes(types ...RelationshipType) QueryOption {
 	return func(q *url.Values) {
-		value := buildCommaSepQuery(types, validRelationshipTypes)
-		q.Set("relationshipTypes", value)
+		setCommaSepQuery(q, "relationshipTypes", types, validRelationshipTypes)
 	}
 }
 
@@ -327,20 +341,25 @@ func LimitRelationshipType(n int64) QueryOption {
 	}
 }
 
-// TypeFormat sets the typeFormat parameter based on string input.
-func TypeFormat(format string) QueryOption {
+// TypeFormat sets the typeFormat parameter based on PronunciationFormat input.
+func TypeFormat(format PronunciationFormat) QueryOption {
 	return func(q *url.Values) {
 		if validTypeFormat[format] {
-			q.Set("typeFormat", format)
+			q.Set("typeFormat", string(format))
+		} else {
+			invalidOption(q, "typeFormat", string(format))
 		}
 	}
 }
 
-// SourceDictionary sets the sourceDictionary parameter based on string input.
-func SourceDictionary(format string) QueryOption {
+// FromSourceDictionary sets the sourceDictionary parameter based on
+// SourceDictionary input.
+func FromSourceDictionary(dict SourceDictionary) QueryOption {
 	return func(q *url.Values) {
-		if validSourceDictionaries[format] {
-			q.Set("sourceDictionary", format)
+		if validSourceDictionaries[dict] {
+			q.Set("sourceDictionary", string(dict))
+		} else {
+			invalidOption(q, "sourceDictionary", string(dict))
 		}
 	}
 }
@@ -34,10 +34,15 @@ func queryTestBools(t *testing.T, testCases []boolQueryTest, f func(bool) QueryO
 	}
 }
 
-func queryTestStringSlices(t *testing.T, testCases []stringSliceQueryTest, f func(...string) QueryOption) {
+func queryTestStringSlices[T ~string](t *testing.T, testCases []stringSliceQueryTest, f func(...T) QueryOption) {
 	for _, testCase := range testCases {
+		items := make([]T, len(testCase.strings))
+		for i, s := range testCase.strings {
+			items[i] = T(s)
+		}
+
 		q := url.Values{}
-		f(testCase.strings...)(&q)
+		f(items...)(&q)
 		if q.Encode() != testCase.expected {
 			t.Errorf("For %v got %q, expected: %q", testCase.strings, q.Encode(), testCase.expected)
 		}
@@ -54,10 +59,10 @@ func queryTestInt64s(t *testing.T, testCases []int64QueryTest, f func(int64) Que
 	}
 }
 
-func queryTestStrings(t *testing.T, testCases []stringQueryTest, f func(string) QueryOption) {
+func queryTestStrings[T ~string](t *testing.T, testCases []stringQueryTest, f func(T) QueryOption) {
 	for _, testCase := range testCases {
 		q := url.Values{}
-		f(testCase.s)(&q)
+		f(T(testCase.s))(&q)
 		if q.Encode() != testCase.expected {
 			t.Errorf("For %v got %q, expected: %q", testCase.s, q.Encode(), testCase.expected)
 		}
@@ -324,8 +329,8 @@ var partOfSpeechTests = []stringSliceQueryTest{
 	{[]string{"noun", "noun"}, "partOfSpeech=noun%2Cnoun%2C"},
 }
 
-func TestPartOfSpeech(t *testing.T) {
-	queryTestStringSlices(t, partOfSpeechTests, PartOfSpeech)
+func TestPartsOfSpeech(t *testing.T) {
+	queryTestStringSlices(t, partOfSpeechTests, PartsOfSpeech)
 }
 
 var sourceDictTests = []stringSliceQueryTest{
@@ -377,8 +382,8 @@ var sourceDictionaryTests = []stringQueryTest{
 	{"century", "sourceDictionary=century"},
 }
 
-func TestSourceDictionary(t *testing.T) {
-	queryTestStrings(t, sourceDictionaryTests, SourceDictionary)
+func TestFromSourceDictionary(t *testing.T) {
+	queryTestStrings(t, sourceDictionaryTests, FromSourceDictionary)
 }
 
 var startYearTests = []int64QueryTest{
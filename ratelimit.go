@@ -0,0 +1,215 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBudgetExhausted is returned by a Client whose RateLimiter is set to fail
+// fast once no calls remain in the current window.
+var ErrBudgetExhausted = errors.New("wordnik: rate limit budget exhausted")
+
+// RateBudget is a snapshot of a RateLimiter's state.
+type RateBudget struct {
+	Limit     int64
+	Remaining int64
+	ResetsAt  time.Time
+}
+
+// RateLimiter is a token bucket which holds the number of calls remaining in
+// the API key's quota window, and refills completely when the window resets.
+// It can be kept in line with the server's view of the quota via Sync. A
+// RateLimiter is safe for concurrent use. The zero value doesn't limit calls;
+// use NewRateLimiter to create one which does.
+type RateLimiter struct {
+	mu        sync.Mutex
+	limit     int64
+	remaining int64
+	window    time.Duration
+	resetsAt  time.Time
+	failFast  bool
+	now       func() time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing limit calls per window, e.g.
+// NewRateLimiter(15000, time.Hour). The first window starts immediately.
+func NewRateLimiter(limit int64, window time.Duration) *RateLimiter {
+	l := &RateLimiter{
+		limit:     limit,
+		remaining: limit,
+		window:    window,
+		now:       time.Now,
+	}
+	l.resetsAt = l.now().Add(window)
+	return l
+}
+
+// SetFailFast makes requests fail with ErrBudgetExhausted when no calls
+// remain, instead of blocking until the window resets.
+func (l *RateLimiter) SetFailFast(failFast bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.failFast = failFast
+}
+
+// Budget returns the current state of the limiter.
+func (l *RateLimiter) Budget() RateBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	return RateBudget{Limit: l.limit, Remaining: l.remaining, ResetsAt: l.resetsAt}
+}
+
+// Sync replaces the limiter's budget with the one reported by the API. The
+// limit becomes the calls remaining plus those already made in the window. If
+// the status doesn't say when the window resets, the current window is kept,
+// or a new one started if it has ended. A limiter without a window, such as
+// the zero value, records the budget but still doesn't limit calls, since it
+// has no way of refilling; use NewRateLimiter for a limiter which does.
+func (l *RateLimiter) Sync(status APITokenStatus) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit := status.RemainingCalls + status.TotalRequests; limit > 0 {
+		l.limit = limit
+	}
+	l.remaining = status.RemainingCalls
+
+	now := l.clock()
+	switch {
+	case status.ResetsInMillis > 0:
+		l.resetsAt = now.Add(time.Duration(status.ResetsInMillis) * time.Millisecond)
+	case !now.Before(l.resetsAt):
+		l.resetsAt = now.Add(l.window)
+	}
+}
+
+// Wait takes one call from the budget, blocking until the window resets if
+// none remain, or returning ErrBudgetExhausted if SetFailFast(true) was called.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.window <= 0 {
+			l.mu.Unlock()
+			return nil
+		}
+
+		l.refill()
+		if l.remaining > 0 {
+			l.remaining--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := l.resetsAt.Sub(l.clock())
+		failFast := l.failFast
+		l.mu.Unlock()
+
+		if failFast {
+			return ErrBudgetExhausted
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// exhaust empties the budget, as when the server reports it has run out
+// before the limiter did. The budget is restored after retryAfter if given.
+func (l *RateLimiter) exhaust(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.remaining = 0
+	if retryAfter > 0 {
+		l.resetsAt = l.clock().Add(retryAfter)
+	}
+}
+
+// refill starts a new window once the current one has ended. l.mu must be
+// held.
+func (l *RateLimiter) refill() {
+	now := l.clock()
+	if l.window <= 0 || now.Before(l.resetsAt) {
+		return
+	}
+
+	l.remaining = l.limit
+	l.resetsAt = now.Add(l.window)
+}
+
+// clock returns the current time, from l.now if set.
+func (l *RateLimiter) clock() time.Time {
+	if l.now == nil {
+		return time.Now()
+	}
+	return l.now()
+}
+
+// SetRateLimiter makes the Client take a call from l's budget before every
+// request, including retries. A nil limiter disables rate limiting.
+func (c *Client) SetRateLimiter(l *RateLimiter) {
+	c.rateLimiter = l
+}
+
+// SyncRateLimiter updates the Client's RateLimiter from GetAPITokenStatus.
+func (c *Client) SyncRateLimiter(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return errors.New("no rate limiter set")
+	}
+
+	status, err := c.GetAPITokenStatusContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.rateLimiter.Sync(status)
+	return nil
+}
+
+// StartRateLimitSync calls SyncRateLimiter every interval until ctx is done.
+// Errors are passed to onError if it is non-nil. It returns an error, without
+// starting, if interval isn't positive.
+func (c *Client) StartRateLimitSync(ctx context.Context, interval time.Duration, onError func(error)) error {
+	if interval <= 0 {
+		return errors.New("rate limit sync interval must be positive")
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.SyncRateLimiter(ctx); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+type rateLimitKey struct{}
+
+// withoutRateLimit marks ctx so that requests made with it skip the Client's
+// RateLimiter.
+func withoutRateLimit(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rateLimitKey{}, true)
+}
+
+// waitRateLimit takes a call from the Client's budget, if it has one.
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	if c.rateLimiter == nil || ctx.Value(rateLimitKey{}) != nil {
+		return nil
+	}
+	return c.rateLimiter.Wait(ctx)
+}
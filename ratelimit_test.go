@@ -0,0 +1,158 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Helper function for testing which returns a RateLimiter with a clock that
+// only moves when the returned function is called.
+func newTestRateLimiter(limit int64, window time.Duration) (*RateLimiter, func(time.Duration)) {
+	now := time.Date(2017, 6, 12, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(limit, window)
+	l.now = func() time.Time { return now }
+	l.resetsAt = now.Add(window)
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestRateLimiterBudget(t *testing.T) {
+	l, advance := newTestRateLimiter(2, time.Hour)
+	l.SetFailFast(true)
+
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := l.Wait(context.Background()); err != ErrBudgetExhausted {
+		t.Errorf("expected ErrBudgetExhausted, got %v", err)
+	}
+
+	advance(time.Hour)
+	if b := l.Budget(); b.Remaining != 2 || b.Limit != 2 {
+		t.Errorf("expected budget to refill after window, got %+v", b)
+	}
+}
+
+func TestRateLimiterSync(t *testing.T) {
+	l, advance := newTestRateLimiter(100, time.Hour)
+	l.Sync(APITokenStatus{RemainingCalls: 1, TotalRequests: 99, ResetsInMillis: 60000})
+
+	b := l.Budget()
+	if b.Remaining != 1 {
+		t.Errorf("expected 1 remaining call after sync, got %d", b.Remaining)
+	}
+
+	advance(time.Minute)
+	if b := l.Budget(); b.Remaining != 100 {
+		t.Errorf("expected budget to reset at synced time, got %d", b.Remaining)
+	}
+}
+
+func TestRateLimiterSyncLimit(t *testing.T) {
+	l, advance := newTestRateLimiter(100, time.Hour)
+	advance(30 * time.Minute)
+	l.Sync(APITokenStatus{RemainingCalls: 400, TotalRequests: 100})
+
+	b := l.Budget()
+	if b.Limit != 500 || b.Remaining != 400 {
+		t.Errorf("expected limit and remaining calls from status, got %+v", b)
+	}
+
+	advance(29 * time.Minute)
+	if b := l.Budget(); b.Remaining != 400 {
+		t.Errorf("expected synced budget to last until the window resets, got %d", b.Remaining)
+	}
+
+	advance(time.Minute)
+	if b := l.Budget(); b.Remaining != 500 {
+		t.Errorf("expected budget to refill to the synced limit, got %d", b.Remaining)
+	}
+}
+
+func TestRateLimiterZeroValue(t *testing.T) {
+	var l RateLimiter
+	l.Sync(APITokenStatus{RemainingCalls: 0})
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("expected zero value not to limit calls, got %v", err)
+	}
+	l.Budget()
+}
+
+func TestStartRateLimitSyncInterval(t *testing.T) {
+	cl := NewClient("abc")
+	cl.SetRateLimiter(NewRateLimiter(10, time.Hour))
+
+	if err := cl.StartRateLimitSync(context.Background(), 0, nil); err == nil {
+		t.Error("expected error for zero interval")
+	}
+}
+
+func TestRateLimiterBlocks(t *testing.T) {
+	l := NewRateLimiter(1, 20*time.Millisecond)
+	start := time.Now()
+	l.Wait(context.Background())
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if time.Since(start) < 10*time.Millisecond {
+		t.Error("expected second call to wait for the window to reset")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled while waiting, got %v", err)
+	}
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/v4/account.json/apiTokenStatus" {
+			w.Write([]byte(`{"remainingCalls":1,"resetsInMillis":3600000}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	l, _ := newTestRateLimiter(10, time.Hour)
+	l.SetFailFast(true)
+	cl.SetRateLimiter(l)
+
+	if err := cl.SyncRateLimiter(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := cl.GetWord("cat"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err := cl.GetWord("cat")
+	if err != ErrBudgetExhausted {
+		t.Errorf("expected ErrBudgetExhausted, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls to reach the server, got %d", calls)
+	}
+}
+
+func TestClientRateLimiterServerExhausted(t *testing.T) {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	l, _ := newTestRateLimiter(10, time.Hour)
+	cl.SetRateLimiter(l)
+	cl.GetWord("cat")
+
+	if b := l.Budget(); b.Remaining != 0 {
+		t.Errorf("expected 429 to exhaust the budget, got %d remaining", b.Remaining)
+	}
+}
@@ -0,0 +1,208 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// defaultRetryableStatus lists the status codes retried when a RetryPolicy
+// doesn't specify its own.
+var defaultRetryableStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy controls whether and how failed requests are retried. The zero
+// value disables retries. By default only GET requests are retried; word list
+// mutations such as AddWordsToWordList are retried only if RetryMutations is
+// set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including the
+	// first one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. It doubles with each
+	// subsequent retry, up to MaxDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. Zero means no cap. A request
+	// whose Retry-After asks for a longer wait than MaxDelay isn't retried,
+	// and fails with the response's error.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction of each delay, between 0 and 1, which is
+	// randomized to avoid retrying in lockstep with other clients. Values
+	// outside that range are clamped to it.
+	Jitter float64
+
+	// RetryableStatus lists the status codes which are retried. If nil,
+	// 429, 500, 502, 503 and 504 are retried.
+	RetryableStatus []int
+
+	// RetryableError decides whether a transport error, such as a connection
+	// reset, is retried. If nil, all transport errors are retried. Errors
+	// caused by the request's context, and errors with a Retryable method
+	// which returns false, are never retried.
+	RetryableError func(error) bool
+
+	// RetryMutations enables retries for POST, PUT and DELETE requests.
+	RetryMutations bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with three attempts, exponential
+// backoff starting at 500ms and capped at 10s, and 20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// SetRetryPolicy replaces the Client's retry policy. It should be called
+// before the Client is used concurrently.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// attemptsFor returns how many attempts the policy allows for req.
+func (p RetryPolicy) attemptsFor(req *http.Request) int {
+	if p.MaxAttempts < 2 {
+		return 1
+	}
+
+	if req.Method != http.MethodGet && !p.RetryMutations {
+		return 1
+	}
+
+	// Bodies which can't be rewound can only be sent once.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether a request which failed with err may be retried.
+func (p RetryPolicy) shouldRetry(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		statuses := p.RetryableStatus
+		if statuses == nil {
+			statuses = defaultRetryableStatus
+		}
+		for _, status := range statuses {
+			if apiErr.StatusCode == status {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Errors can declare themselves permanent, as a wordniktest.Cassette
+	// does for requests it has no recording of.
+	var retryable interface{ Retryable() bool }
+	if errors.As(err, &retryable) && !retryable.Retryable() {
+		return false
+	}
+
+	if p.RetryableError != nil {
+		return p.RetryableError(err)
+	}
+
+	// Anything else which didn't come from the transport, such as a JSON
+	// decoding error, would just fail again.
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// delay returns how long to wait before the given retry, where retry 1 is the
+// second attempt. A Retry-After value sent by the server takes precedence over
+// the computed backoff; delay reports false if it exceeds MaxDelay, in which
+// case the request shouldn't be retried.
+func (p RetryPolicy) delay(retry int, err error) (time.Duration, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		if p.MaxDelay > 0 && apiErr.RetryAfter > p.MaxDelay {
+			return 0, false
+		}
+		return apiErr.RetryAfter, true
+	}
+
+	// Doubling stops before it would overflow, so that a policy with no
+	// MaxDelay saturates rather than wrapping around to no delay.
+	d := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay == 0 || d < p.MaxDelay) && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if jitter := math.Min(p.Jitter, 1); jitter > 0 {
+		spread := time.Duration(float64(d) * jitter)
+		if spread < 0 || spread > d {
+			// float64 rounding can push the spread of a huge delay out of
+			// range.
+			spread = d
+		}
+		if spread == math.MaxInt64 {
+			// Keep the bound passed to rand.Int63n from overflowing.
+			spread--
+		}
+		d -= spread
+		if spread > 0 {
+			d += time.Duration(rand.Int63n(int64(spread) + 1))
+		}
+	}
+
+	return d, true
+}
+
+// parseRetryAfter interprets a Retry-After header, which holds either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// sleepContext waits for d, returning early with the context's error if ctx
+// is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
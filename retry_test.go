@@ -0,0 +1,204 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Helper function for testing which returns a handler that fails with the
+// given status until it has been called n times, then responds with body.
+func failingHandler(calls *int32, n int32, status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= n {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(body))
+	}
+}
+
+func fastRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 2, http.StatusServiceUnavailable, `{"word":"cat"}`))
+	cl.SetRetryPolicy(fastRetryPolicy())
+
+	res, err := cl.GetWord("cat")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Word != "cat" || calls != 3 {
+		t.Errorf("expected success on third attempt, got %q after %d calls", res.Word, calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 5, http.StatusBadGateway, `{}`))
+	cl.SetRetryPolicy(fastRetryPolicy())
+
+	_, err := cl.GetWord("cat")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected 502 APIError, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 5, http.StatusNotFound, `{}`))
+	cl.SetRetryPolicy(fastRetryPolicy())
+
+	cl.GetWord("cat")
+	if calls != 1 {
+		t.Errorf("expected 404 not to be retried, got %d attempts", calls)
+	}
+}
+
+func TestRetryMutations(t *testing.T) {
+	var calls int32
+	var bodies []string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		failingHandler(&calls, 1, http.StatusInternalServerError, ``)(w, r)
+	})
+
+	cl.SetRetryPolicy(fastRetryPolicy())
+	err := cl.AddWordsToWordList("token", "list", []string{"cat"})
+	if err == nil || calls != 1 {
+		t.Errorf("expected POST not to be retried by default, got %d attempts", calls)
+	}
+
+	calls = 0
+	bodies = nil
+	policy := fastRetryPolicy()
+	policy.RetryMutations = true
+	cl.SetRetryPolicy(policy)
+
+	err = cl.AddWordsToWordList("token", "list", []string{"cat"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[1] != `[{"word":"cat"}]` {
+		t.Errorf("expected request body to be resent, got %q", bodies)
+	}
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, failingHandler(&calls, 5, http.StatusServiceUnavailable, `{}`))
+	cl.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := cl.GetWordContext(ctx, "cat")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded during backoff, got %v", err)
+	}
+}
+
+var retryDelayTests = []struct {
+	policy   RetryPolicy
+	retry    int
+	err      error
+	expected time.Duration
+	ok       bool
+}{
+	{RetryPolicy{BaseDelay: time.Second}, 1, nil, time.Second, true},
+	{RetryPolicy{BaseDelay: time.Second}, 3, nil, 4 * time.Second, true},
+	{RetryPolicy{BaseDelay: time.Second, MaxDelay: 3 * time.Second}, 3, nil, 3 * time.Second, true},
+	{RetryPolicy{BaseDelay: time.Second}, 1, &APIError{RetryAfter: 7 * time.Second}, 7 * time.Second, true},
+	{RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}, 1, &APIError{RetryAfter: 7 * time.Second}, 7 * time.Second, true},
+	{RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}, 1, &APIError{RetryAfter: time.Hour}, 0, false},
+}
+
+func TestRetryDelay(t *testing.T) {
+	for _, testCase := range retryDelayTests {
+		d, ok := testCase.policy.delay(testCase.retry, testCase.err)
+		if d != testCase.expected || ok != testCase.ok {
+			t.Errorf("For retry %d got %v, %t, expected %v, %t", testCase.retry, d, ok, testCase.expected, testCase.ok)
+		}
+	}
+
+	jittered := RetryPolicy{BaseDelay: time.Second, Jitter: 0.5}
+	for i := 0; i < 20; i++ {
+		if d, _ := jittered.delay(1, nil); d < 500*time.Millisecond || d > time.Second {
+			t.Errorf("jittered delay %v out of range", d)
+		}
+	}
+
+	uncapped := RetryPolicy{BaseDelay: time.Second}
+	for _, retry := range []int{35, 64, 100} {
+		if d, _ := uncapped.delay(retry, nil); d < time.Duration(math.MaxInt64/2) {
+			t.Errorf("expected uncapped delay for retry %d to saturate, got %v", retry, d)
+		}
+	}
+
+	for _, jitter := range []float64{-1, 2} {
+		policy := RetryPolicy{BaseDelay: time.Second, Jitter: jitter}
+		for i := 0; i < 20; i++ {
+			if d, _ := policy.delay(1, nil); d < 0 || d > time.Second {
+				t.Errorf("delay %v with jitter %v out of range", d, jitter)
+			}
+		}
+	}
+
+	fullJitter := RetryPolicy{BaseDelay: time.Second, Jitter: 1}
+	if d, _ := fullJitter.delay(100, nil); d < 0 {
+		t.Errorf("expected non-negative delay for saturated full jitter, got %v", d)
+	}
+
+	for _, policy := range []RetryPolicy{
+		{BaseDelay: math.MaxInt64, Jitter: 1},
+		{BaseDelay: time.Second, MaxDelay: math.MaxInt64, Jitter: 1},
+	} {
+		if d, _ := policy.delay(100, nil); d < 0 {
+			t.Errorf("expected non-negative delay for %+v, got %v", policy, d)
+		}
+	}
+}
+
+func TestRetryAfterBeyondMaxDelay(t *testing.T) {
+	var calls int32
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	cl.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Second})
+
+	_, err := cl.GetWord("cat")
+	if !IsRateLimited(err) {
+		t.Errorf("expected rate limit error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected no retry for Retry-After beyond MaxDelay, got %d calls", calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d := parseRetryAfter("120"); d != 2*time.Minute {
+		t.Errorf("got %v, expected 2m", d)
+	}
+	if d := parseRetryAfter("soon"); d != 0 {
+		t.Errorf("got %v, expected 0 for invalid header", d)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d := parseRetryAfter(date); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("got %v, expected about an hour", d)
+	}
+}
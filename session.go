@@ -0,0 +1,299 @@
+package wordnik
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// Session makes user-scoped calls on behalf of one account, managing its auth
+// token. The token is obtained with AuthenticatePOST on first use and cached;
+// if a call fails with 401 Unauthorized, as happens once the token expires,
+// the Session authenticates again and retries the call once. A Session is safe
+// for concurrent use.
+type Session struct {
+	client   *Client
+	username string
+	password string
+
+	mu    sync.Mutex
+	token AuthenticationToken
+}
+
+// NewSession returns a Session for the account with the given username and
+// password, making calls with c. No request is made until the Session is used.
+func NewSession(c *Client, username, password string) *Session {
+	return &Session{client: c, username: username, password: password}
+}
+
+// Client returns the Client the Session makes calls with.
+func (s *Session) Client() *Client {
+	return s.client
+}
+
+// Token returns the Session's auth token, authenticating first if it doesn't
+// have one.
+func (s *Session) Token() (AuthenticationToken, error) {
+	return s.TokenContext(context.Background())
+}
+
+// TokenContext is like Token, but carries a context for cancellation and
+// deadlines.
+func (s *Session) TokenContext(ctx context.Context) (AuthenticationToken, error) {
+	return s.refresh(ctx, "")
+}
+
+// Authenticate discards the Session's auth token, if any, and authenticates
+// again.
+func (s *Session) Authenticate() (AuthenticationToken, error) {
+	return s.AuthenticateContext(context.Background())
+}
+
+// AuthenticateContext is like Authenticate, but carries a context for
+// cancellation and deadlines.
+func (s *Session) AuthenticateContext(ctx context.Context) (AuthenticationToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = AuthenticationToken{}
+	return s.authenticate(ctx)
+}
+
+// refresh returns the cached token, unless it is empty or equal to stale, in
+// which case it authenticates again. Comparing against stale means that when
+// several calls fail with the same expired token, only the first of them
+// authenticates.
+func (s *Session) refresh(ctx context.Context, stale string) (AuthenticationToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Token != "" && s.token.Token != stale {
+		return s.token, nil
+	}
+	return s.authenticate(ctx)
+}
+
+// authenticate obtains and caches a new token. s.mu must be held.
+func (s *Session) authenticate(ctx context.Context) (AuthenticationToken, error) {
+	token, err := s.client.AuthenticatePOSTContext(ctx, s.username, s.password)
+	if err != nil {
+		return AuthenticationToken{}, err
+	}
+
+	s.token = token
+	return token, nil
+}
+
+// withToken calls call with the Session's auth token, authenticating again and
+// retrying once if it fails with 401 Unauthorized.
+func withToken[T any](ctx context.Context, s *Session, call func(token string) (T, error)) (T, error) {
+	token, err := s.refresh(ctx, "")
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	result, err := call(token.Token)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		return result, err
+	}
+
+	token, err = s.refresh(ctx, token.Token)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return call(token.Token)
+}
+
+// withTokenErr is like withToken, for calls which only return an error.
+func withTokenErr(ctx context.Context, s *Session, call func(token string) error) error {
+	_, err := withToken(ctx, s, func(token string) (struct{}, error) {
+		return struct{}{}, call(token)
+	})
+	return err
+}
+
+// GetUser is like Client.GetUser, using the Session's auth token.
+func (s *Session) GetUser() (User, error) {
+	return s.GetUserContext(context.Background())
+}
+
+// GetUserContext is like GetUser, but carries a context for cancellation and
+// deadlines.
+func (s *Session) GetUserContext(ctx context.Context) (User, error) {
+	return withToken(ctx, s, func(token string) (User, error) {
+		return s.client.GetUserContext(ctx, token)
+	})
+}
+
+// GetWordListsForUser is like Client.GetWordListsForUser, using the Session's
+// auth token.
+func (s *Session) GetWordListsForUser(options ...QueryOption) ([]WordList, error) {
+	return s.GetWordListsForUserContext(context.Background(), options...)
+}
+
+// GetWordListsForUserContext is like GetWordListsForUser, but carries a context
+// for cancellation and deadlines.
+func (s *Session) GetWordListsForUserContext(ctx context.Context, options ...QueryOption) ([]WordList, error) {
+	return withToken(ctx, s, func(token string) ([]WordList, error) {
+		return s.client.GetWordListsForUserContext(ctx, token, options...)
+	})
+}
+
+// WordListsForUserIter is like Client.WordListsForUserIter, using the
+// Session's auth token for each page.
+func (s *Session) WordListsForUserIter(options ...QueryOption) *Pager[WordList] {
+	return s.WordListsForUserIterContext(context.Background(), options...)
+}
+
+// WordListsForUserIterContext is like WordListsForUserIter, but carries a
+// context for cancellation and deadlines.
+func (s *Session) WordListsForUserIterContext(ctx context.Context, options ...QueryOption) *Pager[WordList] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]WordList, int64, error) {
+		lists, err := s.GetWordListsForUserContext(ctx, pageOptions(options, skip, limit)...)
+		return lists, 0, err
+	}
+
+	return newPager(ctx, fetch, false, options)
+}
+
+// AllWordListsForUser is like Client.AllWordListsForUser, using the Session's
+// auth token.
+func (s *Session) AllWordListsForUser(options ...QueryOption) ([]WordList, error) {
+	return s.AllWordListsForUserContext(context.Background(), options...)
+}
+
+// AllWordListsForUserContext is like AllWordListsForUser, but carries a context
+// for cancellation and deadlines.
+func (s *Session) AllWordListsForUserContext(ctx context.Context, options ...QueryOption) ([]WordList, error) {
+	return s.WordListsForUserIterContext(ctx, options...).Collect()
+}
+
+// CreateWordList is like Client.CreateWordList, using the Session's auth
+// token.
+func (s *Session) CreateWordList(list WordList) (WordList, error) {
+	return s.CreateWordListContext(context.Background(), list)
+}
+
+// CreateWordListContext is like CreateWordList, but carries a context for
+// cancellation and deadlines.
+func (s *Session) CreateWordListContext(ctx context.Context, list WordList) (WordList, error) {
+	return withToken(ctx, s, func(token string) (WordList, error) {
+		return s.client.CreateWordListContext(ctx, token, list)
+	})
+}
+
+// GetWordList is like Client.GetWordList, using the Session's auth token.
+func (s *Session) GetWordList(permalink string) (WordList, error) {
+	return s.GetWordListContext(context.Background(), permalink)
+}
+
+// GetWordListContext is like GetWordList, but carries a context for
+// cancellation and deadlines.
+func (s *Session) GetWordListContext(ctx context.Context, permalink string) (WordList, error) {
+	return withToken(ctx, s, func(token string) (WordList, error) {
+		return s.client.GetWordListContext(ctx, token, permalink)
+	})
+}
+
+// UpdateWordList is like Client.UpdateWordList, using the Session's auth
+// token.
+func (s *Session) UpdateWordList(permalink string, wList WordList) error {
+	return s.UpdateWordListContext(context.Background(), permalink, wList)
+}
+
+// UpdateWordListContext is like UpdateWordList, but carries a context for
+// cancellation and deadlines.
+func (s *Session) UpdateWordListContext(ctx context.Context, permalink string, wList WordList) error {
+	return withTokenErr(ctx, s, func(token string) error {
+		return s.client.UpdateWordListContext(ctx, token, permalink, wList)
+	})
+}
+
+// DeleteWordList is like Client.DeleteWordList, using the Session's auth
+// token.
+func (s *Session) DeleteWordList(permalink string) error {
+	return s.DeleteWordListContext(context.Background(), permalink)
+}
+
+// DeleteWordListContext is like DeleteWordList, but carries a context for
+// cancellation and deadlines.
+func (s *Session) DeleteWordListContext(ctx context.Context, permalink string) error {
+	return withTokenErr(ctx, s, func(token string) error {
+		return s.client.DeleteWordListContext(ctx, token, permalink)
+	})
+}
+
+// AddWordsToWordList is like Client.AddWordsToWordList, using the Session's
+// auth token.
+func (s *Session) AddWordsToWordList(permalink string, words []string) error {
+	return s.AddWordsToWordListContext(context.Background(), permalink, words)
+}
+
+// AddWordsToWordListContext is like AddWordsToWordList, but carries a context
+// for cancellation and deadlines.
+func (s *Session) AddWordsToWordListContext(ctx context.Context, permalink string, words []string) error {
+	return withTokenErr(ctx, s, func(token string) error {
+		return s.client.AddWordsToWordListContext(ctx, token, permalink, words)
+	})
+}
+
+// GetWordListWords is like Client.GetWordListWords, using the Session's auth
+// token.
+func (s *Session) GetWordListWords(permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return s.GetWordListWordsContext(context.Background(), permalink, options...)
+}
+
+// GetWordListWordsContext is like GetWordListWords, but carries a context for
+// cancellation and deadlines.
+func (s *Session) GetWordListWordsContext(ctx context.Context, permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return withToken(ctx, s, func(token string) ([]WordListWord, error) {
+		return s.client.GetWordListWordsContext(ctx, token, permalink, options...)
+	})
+}
+
+// WordListWordsIter is like Client.WordListWordsIter, using the Session's auth
+// token for each page.
+func (s *Session) WordListWordsIter(permalink string, options ...QueryOption) *Pager[WordListWord] {
+	return s.WordListWordsIterContext(context.Background(), permalink, options...)
+}
+
+// WordListWordsIterContext is like WordListWordsIter, but carries a context for
+// cancellation and deadlines.
+func (s *Session) WordListWordsIterContext(ctx context.Context, permalink string, options ...QueryOption) *Pager[WordListWord] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]WordListWord, int64, error) {
+		words, err := s.GetWordListWordsContext(ctx, permalink, pageOptions(options, skip, limit)...)
+		return words, 0, err
+	}
+
+	return newPager(ctx, fetch, false, options)
+}
+
+// AllWordListWords is like Client.AllWordListWords, using the Session's auth
+// token.
+func (s *Session) AllWordListWords(permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return s.AllWordListWordsContext(context.Background(), permalink, options...)
+}
+
+// AllWordListWordsContext is like AllWordListWords, but carries a context for
+// cancellation and deadlines.
+func (s *Session) AllWordListWordsContext(ctx context.Context, permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return s.WordListWordsIterContext(ctx, permalink, options...).Collect()
+}
+
+// DeleteWordsFromWordList is like Client.DeleteWordsFromWordList, using the
+// Session's auth token.
+func (s *Session) DeleteWordsFromWordList(permalink string, words []string) error {
+	return s.DeleteWordsFromWordListContext(context.Background(), permalink, words)
+}
+
+// DeleteWordsFromWordListContext is like DeleteWordsFromWordList, but carries a
+// context for cancellation and deadlines.
+func (s *Session) DeleteWordsFromWordListContext(ctx context.Context, permalink string, words []string) error {
+	return withTokenErr(ctx, s, func(token string) error {
+		return s.client.DeleteWordsFromWordListContext(ctx, token, permalink, words)
+	})
+}
@@ -0,0 +1,132 @@
+package wordnik
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
+)
+
+// Helper function for testing which returns a Session for the default user of
+// a new wordniktest.Server.
+func newTestSession(t *testing.T, password string) (*Session, *wordniktest.Server) {
+	cl, srv := newFixtureClient(t, wordniktest.DefaultFixtures(), wordniktest.DefaultAPIKey)
+	return NewSession(cl, wordniktest.DefaultUsername, password), srv
+}
+
+// authentications counts the authenticate requests srv has received.
+func authentications(srv *wordniktest.Server) int {
+	n := 0
+	for _, req := range srv.Requests() {
+		if req.Path == "account.json/authenticate/"+wordniktest.DefaultUsername {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSession(t *testing.T) {
+	s, srv := newTestSession(t, wordniktest.DefaultPassword)
+	if authentications(srv) != 0 {
+		t.Error("expected NewSession not to authenticate")
+	}
+
+	list, err := s.CreateWordList(WordList{Name: "session test", Type: "PRIVATE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddWordsToWordList(list.Permalink, []string{"lamp", "speaker"}); err != nil {
+		t.Fatal(err)
+	}
+
+	words, err := s.AllWordListWords(list.Permalink)
+	if err != nil || len(words) != 2 {
+		t.Errorf("expected 2 words, got %d, err %v", len(words), err)
+	}
+	if authentications(srv) != 1 {
+		t.Errorf("expected the token to be cached, got %d authentications", authentications(srv))
+	}
+
+	srv.ExpireTokens()
+
+	user, err := s.GetUser()
+	if err != nil {
+		t.Fatalf("expected Session to authenticate again, got %v", err)
+	}
+	if user.Username != wordniktest.DefaultUsername {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	if authentications(srv) != 2 {
+		t.Errorf("expected 2 authentications, got %d", authentications(srv))
+	}
+
+	if err := s.DeleteWordList(list.Permalink); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSessionConcurrentReauthentication(t *testing.T) {
+	s, srv := newTestSession(t, wordniktest.DefaultPassword)
+	if _, err := s.Token(); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ExpireTokens()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.GetWordListsForUser(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if authentications(srv) != 2 {
+		t.Errorf("expected a single reauthentication, got %d authentications", authentications(srv))
+	}
+}
+
+func TestSessionForbidden(t *testing.T) {
+	fixtures := wordniktest.DefaultFixtures()
+	fixtures.Users = append(fixtures.Users, wordniktest.User{
+		ID:        1002,
+		Username:  "other",
+		Password:  "other-pass",
+		WordLists: []wordniktest.WordList{{ID: 2, Permalink: "secrets--2", Name: "secrets", Type: "PRIVATE"}},
+	})
+	srv := wordniktest.NewServer(fixtures)
+	t.Cleanup(srv.Close)
+
+	cl, err := NewClientWithOptions(wordniktest.DefaultAPIKey, WithBaseURL(srv.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSession(cl, wordniktest.DefaultUsername, wordniktest.DefaultPassword)
+
+	_, err = s.GetWordList("secrets--2")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected 403 error for another user's list, got %v", err)
+	}
+	if authentications(srv) != 1 {
+		t.Errorf("expected 403 not to cause reauthentication, got %d authentications", authentications(srv))
+	}
+}
+
+func TestSessionBadCredentials(t *testing.T) {
+	s, srv := newTestSession(t, "wrong")
+
+	_, err := s.GetUser()
+	if !IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+	if authentications(srv) != 1 {
+		t.Errorf("expected 1 authentication attempt, got %d", authentications(srv))
+	}
+}
@@ -0,0 +1,129 @@
+package wordnik
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Internal query keys used while options are applied in strict mode. They are
+// removed before a request is sent.
+const (
+	strictKey  = "\x00strict"
+	invalidKey = "\x00invalid"
+)
+
+// ValidationError is returned by a Client in strict mode when query options
+// are invalid. No request is sent.
+type ValidationError struct {
+	// Endpoint is the name of the Client method, such as "SearchWords".
+	Endpoint string
+
+	// Problems describes each invalid option.
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "wordnik: invalid options for " + e.Endpoint + ": " + strings.Join(e.Problems, "; ")
+}
+
+// SetStrictValidation makes the Client reject invalid query options with a
+// ValidationError, rather than silently dropping invalid values and leaving
+// out-of-range values to the API. It should be called before the Client is
+// used concurrently.
+func (c *Client) SetStrictValidation(strict bool) {
+	c.strict = strict
+}
+
+// applyOptions applies options to vals. In strict mode, invalid options are
+// reported as a ValidationError for endpoint, as are inapplicable options if
+// the Client's OptionPolicy rejects them.
+func (c *Client) applyOptions(endpoint string, vals *url.Values, options []QueryOption) error {
+	var defaults map[string]bool
+	if c.optionPolicy != AllowInapplicableOptions {
+		defaults = map[string]bool{}
+		for param := range *vals {
+			defaults[param] = true
+		}
+	}
+
+	if c.strict {
+		vals.Set(strictKey, "true")
+	}
+
+	for _, option := range options {
+		option(vals)
+	}
+
+	problems := (*vals)[invalidKey]
+	vals.Del(strictKey)
+	vals.Del(invalidKey)
+
+	if c.strict {
+		problems = append(problems, rangeProblems(*vals)...)
+	}
+	problems = append(problems, c.checkApplicability(endpoint, *vals, defaults)...)
+
+	if len(problems) > 0 {
+		return &ValidationError{Endpoint: endpoint, Problems: problems}
+	}
+
+	return nil
+}
+
+// invalidOption records that value was dropped from param, if the options are
+// being applied in strict mode.
+func invalidOption(q *url.Values, param, value string) {
+	if q.Get(strictKey) != "" {
+		q.Add(invalidKey, param+": invalid value "+strconv.Quote(value))
+	}
+}
+
+// minimums lists parameters which may not be negative.
+var minimums = []string{"skip", "limit", "minCorpusCount", "minDictionaryCount", "minLength", "limitRelationshipType"}
+
+// bounds pairs minimum and maximum parameters. A negative maximum means no
+// limit.
+var bounds = [][2]string{
+	{"minCorpusCount", "maxCorpusCount"},
+	{"minDictionaryCount", "maxDictionaryCount"},
+	{"minLength", "maxLength"},
+	{"startYear", "endYear"},
+}
+
+// rangeProblems describes numeric parameters in vals which are out of range.
+func rangeProblems(vals url.Values) []string {
+	var problems []string
+
+	for _, param := range minimums {
+		if n, ok := intValue(vals, param); ok && n < 0 {
+			problems = append(problems, param+" must not be negative, got "+strconv.FormatInt(n, 10))
+		}
+	}
+
+	for _, pair := range bounds[:3] {
+		if n, ok := intValue(vals, pair[1]); ok && n < -1 {
+			problems = append(problems, pair[1]+" must be -1 (no limit) or more, got "+strconv.FormatInt(n, 10))
+		}
+	}
+
+	for _, pair := range bounds {
+		min, minOK := intValue(vals, pair[0])
+		max, maxOK := intValue(vals, pair[1])
+		if minOK && maxOK && max >= 0 && min > max {
+			problems = append(problems, pair[0]+" "+strconv.FormatInt(min, 10)+" is greater than "+pair[1]+" "+strconv.FormatInt(max, 10))
+		}
+	}
+
+	return problems
+}
+
+// intValue returns the integer value of param, if set.
+func intValue(vals url.Values, param string) (int64, bool) {
+	if vals.Get(param) == "" {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(vals.Get(param), 10, 64)
+	return n, err == nil
+}
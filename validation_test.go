@@ -0,0 +1,184 @@
+package wordnik
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newStrictTestClient(t *testing.T, calls *int32) *Client {
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		w.Write([]byte(`{}`))
+	})
+	cl.SetStrictValidation(true)
+	return cl
+}
+
+var strictValidationTests = []struct {
+	name    string
+	call    func(*Client) error
+	problem string
+}{
+	{
+		"invalid part of speech",
+		func(cl *Client) error {
+			_, err := cl.SearchWords("cat", IncludePartOfSpeech("noun", "nouns"))
+			return err
+		},
+		`includePartOfSpeech: invalid value "nouns"`,
+	},
+	{
+		"invalid sort criteria",
+		func(cl *Client) error {
+			_, err := cl.ReverseDictionary("cat", SortBy("size"))
+			return err
+		},
+		`sortBy: invalid value "size"`,
+	},
+	{
+		"invalid type format",
+		func(cl *Client) error {
+			_, err := cl.Pronunciations("cat", TypeFormat("ipa"))
+			return err
+		},
+		`typeFormat: invalid value "ipa"`,
+	},
+	{
+		"negative limit",
+		func(cl *Client) error {
+			_, err := cl.GetWordListWords("token", "list", Limit(-5))
+			return err
+		},
+		"limit must not be negative, got -5",
+	},
+	{
+		"max length below -1",
+		func(cl *Client) error {
+			_, err := cl.RandomWords(MaxLength(-2))
+			return err
+		},
+		"maxLength must be -1 (no limit) or more, got -2",
+	},
+	{
+		"min length above max length",
+		func(cl *Client) error {
+			_, err := cl.RandomWord(MinLength(8), MaxLength(4))
+			return err
+		},
+		"minLength 8 is greater than maxLength 4",
+	},
+	{
+		"start year after end year",
+		func(cl *Client) error {
+			_, err := cl.GetWordFrequency("cat", StartYear(2010), EndYear(2000))
+			return err
+		},
+		"startYear 2010 is greater than endYear 2000",
+	},
+}
+
+func TestStrictValidation(t *testing.T) {
+	for _, tt := range strictValidationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			cl := newStrictTestClient(t, &calls)
+
+			err := tt.call(cl)
+			var valErr *ValidationError
+			if !errors.As(err, &valErr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.problem) {
+				t.Errorf("expected error to mention %q, got %q", tt.problem, err)
+			}
+			if calls != 0 {
+				t.Errorf("expected no request to be sent, got %d", calls)
+			}
+		})
+	}
+}
+
+func TestStrictValidationReportsEveryProblem(t *testing.T) {
+	var calls int32
+	cl := newStrictTestClient(t, &calls)
+
+	_, err := cl.SearchWords("cat", ExcludePartOfSpeech("verbs"), Skip(-1), MinCorpusCount(10), MaxCorpusCount(5))
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if valErr.Endpoint != "SearchWords" {
+		t.Errorf("expected endpoint SearchWords, got %q", valErr.Endpoint)
+	}
+	if len(valErr.Problems) != 3 {
+		t.Errorf("expected 3 problems, got %q", valErr.Problems)
+	}
+}
+
+func TestStrictValidationAllowsValidOptions(t *testing.T) {
+	var query string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`[]`))
+	})
+	cl.SetStrictValidation(true)
+
+	_, err := cl.RandomWords(MinLength(5), MaxLength(5), MaxCorpusCount(-1), IncludePartOfSpeech("noun"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(query, "%00") {
+		t.Errorf("internal validation keys leaked into query: %s", query)
+	}
+}
+
+func TestNonStrictValidationDropsInvalidValues(t *testing.T) {
+	var calls int32
+	var query string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		query = r.URL.Query().Get("includePartOfSpeech")
+		w.Write([]byte(`{}`))
+	})
+
+	_, err := cl.SearchWords("cat", IncludePartOfSpeech("nouns"), MinLength(8), MaxLength(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 || query != "" {
+		t.Errorf("expected request with empty includePartOfSpeech, got %d calls and %q", calls, query)
+	}
+}
+
+func TestOptionReassigningQuery(t *testing.T) {
+	var query string
+	cl := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("minLength")
+		w.Write([]byte(`{}`))
+	})
+
+	// An option may replace the query rather than mutating it.
+	replace := func(q *url.Values) {
+		vals := url.Values{}
+		for param, values := range *q {
+			vals[param] = values
+		}
+		vals.Set("minLength", "8")
+		*q = vals
+	}
+
+	for _, strict := range []bool{false, true} {
+		query = ""
+		cl.SetStrictValidation(strict)
+		if _, err := cl.SearchWords("cat", replace); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if query != "8" {
+			t.Errorf("expected replaced query to be sent with strict %t, got minLength %q", strict, query)
+		}
+	}
+}
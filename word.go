@@ -1,6 +1,7 @@
 package wordnik
 
 import (
+	"context"
 	"errors"
 	"net/url"
 )
@@ -61,17 +62,17 @@ type Sentence struct {
 
 // ScoredWord as defined by the Wordnik API.
 type ScoredWord struct {
-	Position      string `json:"position"`
-	ID            string `json:"id"`
-	DocTermCount  string `json:"docTermCount"`
-	Lemma         string `json:"lemma"`
-	WordType      string `json:"wordType"`
-	Score         string `json:"score"`
-	SentenceID    string `json:"sentenceId"`
-	Word          string `json:"word"`
-	Stopword      string `json:"stopword"`
-	BaseWordScore string `json:"baseWordScore"`
-	PartOfSpeech  string `json:"partOfSpeech"`
+	Position      string       `json:"position"`
+	ID            string       `json:"id"`
+	DocTermCount  string       `json:"docTermCount"`
+	Lemma         string       `json:"lemma"`
+	WordType      string       `json:"wordType"`
+	Score         string       `json:"score"`
+	SentenceID    string       `json:"sentenceId"`
+	Word          string       `json:"word"`
+	Stopword      string       `json:"stopword"`
+	BaseWordScore string       `json:"baseWordScore"`
+	PartOfSpeech  PartOfSpeech `json:"partOfSpeech"`
 }
 
 // Syllable as defined by the Wordnik API.
@@ -134,6 +135,12 @@ type AudioFile struct {
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetExamples(word string, queryOptions ...QueryOption) (ExampleSearchResults, error) {
+	return c.GetExamplesContext(context.Background(), word, queryOptions...)
+}
+
+// GetExamplesContext is like GetExamples, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetExamplesContext(ctx context.Context, word string, queryOptions ...QueryOption) (ExampleSearchResults, error) {
 	if word == "" {
 		return ExampleSearchResults{}, errors.New("empty query string not allowed")
 	}
@@ -149,7 +156,7 @@ func (c *Client) GetExamples(word string, queryOptions ...QueryOption) (ExampleS
 	}
 
 	var results ExampleSearchResults
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetExamples", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -158,6 +165,12 @@ func (c *Client) GetExamples(word string, queryOptions ...QueryOption) (ExampleS
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetWord(word string, queryOptions ...QueryOption) (WordObject, error) {
+	return c.GetWordContext(context.Background(), word, queryOptions...)
+}
+
+// GetWordContext is like GetWord, but carries a context for cancellation and
+// deadlines.
+func (c *Client) GetWordContext(ctx context.Context, word string, queryOptions ...QueryOption) (WordObject, error) {
 	if word == "" {
 		return WordObject{}, errors.New("empty query string not allowed")
 	}
@@ -171,7 +184,7 @@ func (c *Client) GetWord(word string, queryOptions ...QueryOption) (WordObject,
 	}
 
 	var results WordObject
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetWord", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -180,6 +193,12 @@ func (c *Client) GetWord(word string, queryOptions ...QueryOption) (WordObject,
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetDefinitions(word string, queryOptions ...QueryOption) ([]Definition, error) {
+	return c.GetDefinitionsContext(context.Background(), word, queryOptions...)
+}
+
+// GetDefinitionsContext is like GetDefinitions, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetDefinitionsContext(ctx context.Context, word string, queryOptions ...QueryOption) ([]Definition, error) {
 	if word == "" {
 		return []Definition{}, errors.New("empty query string not allowed")
 	}
@@ -195,7 +214,7 @@ func (c *Client) GetDefinitions(word string, queryOptions ...QueryOption) ([]Def
 	}
 
 	var results []Definition
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetDefinitions", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -204,6 +223,12 @@ func (c *Client) GetDefinitions(word string, queryOptions ...QueryOption) ([]Def
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) TopExample(word string, options ...QueryOption) (Example, error) {
+	return c.TopExampleContext(context.Background(), word, options...)
+}
+
+// TopExampleContext is like TopExample, but carries a context for cancellation
+// and deadlines.
+func (c *Client) TopExampleContext(ctx context.Context, word string, options ...QueryOption) (Example, error) {
 	if word == "" {
 		return Example{}, errors.New("empty query string not allowed")
 	}
@@ -214,7 +239,7 @@ func (c *Client) TopExample(word string, options ...QueryOption) (Example, error
 	q := url.Values{"useCanonical": []string{"false"}}
 
 	var results Example
-	err := c.basicGetRequest(rel, q, &results, options...)
+	err := c.basicGetRequest(ctx, "TopExample", rel, q, &results, options...)
 
 	return results, err
 }
@@ -223,6 +248,12 @@ func (c *Client) TopExample(word string, options ...QueryOption) (Example, error
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetRelatedWords(word string, queryOptions ...QueryOption) ([]RelatedWord, error) {
+	return c.GetRelatedWordsContext(context.Background(), word, queryOptions...)
+}
+
+// GetRelatedWordsContext is like GetRelatedWords, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetRelatedWordsContext(ctx context.Context, word string, queryOptions ...QueryOption) ([]RelatedWord, error) {
 	if word == "" {
 		return []RelatedWord{}, errors.New("empty query string not allowed")
 	}
@@ -236,7 +267,7 @@ func (c *Client) GetRelatedWords(word string, queryOptions ...QueryOption) ([]Re
 	}
 
 	var results []RelatedWord
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetRelatedWords", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -245,6 +276,12 @@ func (c *Client) GetRelatedWords(word string, queryOptions ...QueryOption) ([]Re
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) Pronunciations(word string, queryOptions ...QueryOption) ([]TextPron, error) {
+	return c.PronunciationsContext(context.Background(), word, queryOptions...)
+}
+
+// PronunciationsContext is like Pronunciations, but carries a context for
+// cancellation and deadlines.
+func (c *Client) PronunciationsContext(ctx context.Context, word string, queryOptions ...QueryOption) ([]TextPron, error) {
 	if word == "" {
 		return []TextPron{}, errors.New("empty query string not allowed")
 	}
@@ -258,7 +295,7 @@ func (c *Client) Pronunciations(word string, queryOptions ...QueryOption) ([]Tex
 	}
 
 	var results []TextPron
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "Pronunciations", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -267,6 +304,12 @@ func (c *Client) Pronunciations(word string, queryOptions ...QueryOption) ([]Tex
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) Hyphenation(word string, queryOptions ...QueryOption) ([]Syllable, error) {
+	return c.HyphenationContext(context.Background(), word, queryOptions...)
+}
+
+// HyphenationContext is like Hyphenation, but carries a context for
+// cancellation and deadlines.
+func (c *Client) HyphenationContext(ctx context.Context, word string, queryOptions ...QueryOption) ([]Syllable, error) {
 	if word == "" {
 		return []Syllable{}, errors.New("empty query string not allowed")
 	}
@@ -280,7 +323,7 @@ func (c *Client) Hyphenation(word string, queryOptions ...QueryOption) ([]Syllab
 	}
 
 	var results []Syllable
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "Hyphenation", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -289,6 +332,12 @@ func (c *Client) Hyphenation(word string, queryOptions ...QueryOption) ([]Syllab
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetWordFrequency(word string, queryOptions ...QueryOption) (FrequencySummary, error) {
+	return c.GetWordFrequencyContext(context.Background(), word, queryOptions...)
+}
+
+// GetWordFrequencyContext is like GetWordFrequency, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetWordFrequencyContext(ctx context.Context, word string, queryOptions ...QueryOption) (FrequencySummary, error) {
 	if word == "" {
 		return FrequencySummary{}, errors.New("empty query string not allowed")
 	}
@@ -301,7 +350,7 @@ func (c *Client) GetWordFrequency(word string, queryOptions ...QueryOption) (Fre
 	}
 
 	var results FrequencySummary
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetWordFrequency", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -310,6 +359,12 @@ func (c *Client) GetWordFrequency(word string, queryOptions ...QueryOption) (Fre
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetPhrases(word string, queryOptions ...QueryOption) ([]Bigram, error) {
+	return c.GetPhrasesContext(context.Background(), word, queryOptions...)
+}
+
+// GetPhrasesContext is like GetPhrases, but carries a context for cancellation
+// and deadlines.
+func (c *Client) GetPhrasesContext(ctx context.Context, word string, queryOptions ...QueryOption) ([]Bigram, error) {
 	if word == "" {
 		return []Bigram{}, errors.New("empty query string not allowed")
 	}
@@ -324,7 +379,7 @@ func (c *Client) GetPhrases(word string, queryOptions ...QueryOption) ([]Bigram,
 	}
 
 	var results []Bigram
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetPhrases", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -333,6 +388,12 @@ func (c *Client) GetPhrases(word string, queryOptions ...QueryOption) ([]Bigram,
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetEtymologies(word string, queryOptions ...QueryOption) (EtymologiesResponse, error) {
+	return c.GetEtymologiesContext(context.Background(), word, queryOptions...)
+}
+
+// GetEtymologiesContext is like GetEtymologies, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetEtymologiesContext(ctx context.Context, word string, queryOptions ...QueryOption) (EtymologiesResponse, error) {
 	if word == "" {
 		return EtymologiesResponse{}, errors.New("empty query string not allowed")
 	}
@@ -343,7 +404,7 @@ func (c *Client) GetEtymologies(word string, queryOptions ...QueryOption) (Etymo
 	q := url.Values{"useCanonical": []string{"false"}}
 
 	var results EtymologiesResponse
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetEtymologies", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -352,6 +413,12 @@ func (c *Client) GetEtymologies(word string, queryOptions ...QueryOption) (Etymo
 // Configured with QueryOption functions, which ensure basic parameter
 // vailidity.
 func (c *Client) GetAudio(word string, queryOptions ...QueryOption) ([]AudioFile, error) {
+	return c.GetAudioContext(context.Background(), word, queryOptions...)
+}
+
+// GetAudioContext is like GetAudio, but carries a context for cancellation and
+// deadlines.
+func (c *Client) GetAudioContext(ctx context.Context, word string, queryOptions ...QueryOption) ([]AudioFile, error) {
 	if word == "" {
 		return []AudioFile{}, errors.New("empty query string not allowed")
 	}
@@ -365,7 +432,7 @@ func (c *Client) GetAudio(word string, queryOptions ...QueryOption) ([]AudioFile
 	}
 
 	var results []AudioFile
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "GetAudio", rel, q, &results, queryOptions...)
 
 	return results, err
 }
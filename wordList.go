@@ -2,6 +2,7 @@ package wordnik
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/url"
@@ -40,20 +41,26 @@ type WordListWord struct {
 
 // DeleteWordList deletes a WordList for a given user.
 func (c *Client) DeleteWordList(authToken, permalink string) error {
+	return c.DeleteWordListContext(context.Background(), authToken, permalink)
+}
+
+// DeleteWordListContext is like DeleteWordList, but carries a context for
+// cancellation and deadlines.
+func (c *Client) DeleteWordListContext(ctx context.Context, authToken, permalink string) error {
 	if authToken == "" || permalink == "" {
 		return errors.New("empty auth token  or permalink not allowed")
 	}
 
 	rel := &url.URL{Path: "wordList.json/" + permalink}
 
-	req, err := c.formRequest(rel, url.Values{}, "DELETE")
+	req, err := c.formRequest(ctx, rel, url.Values{}, "DELETE")
 	if err != nil {
 		return err
 	}
 
 	req.Header["auth_token"] = []string{authToken}
 
-	err = c.doRequest(req, nil)
+	err = c.doRequest("DeleteWordList", req, nil)
 
 	return err
 }
@@ -62,6 +69,12 @@ func (c *Client) DeleteWordList(authToken, permalink string) error {
 // the properties of the WordList itself, not to adding or deleting words from
 // the list.
 func (c *Client) UpdateWordList(authToken, permalink string, wList WordList) error {
+	return c.UpdateWordListContext(context.Background(), authToken, permalink, wList)
+}
+
+// UpdateWordListContext is like UpdateWordList, but carries a context for
+// cancellation and deadlines.
+func (c *Client) UpdateWordListContext(ctx context.Context, authToken, permalink string, wList WordList) error {
 	if authToken == "" || permalink == "" {
 		return errors.New("empty auth token  or permalink not allowed")
 	}
@@ -73,27 +86,33 @@ func (c *Client) UpdateWordList(authToken, permalink string, wList WordList) err
 	}
 
 	body := bytes.NewBuffer(marshalledList)
-	req, err := c.formRequest(rel, url.Values{}, "PUT", body)
+	req, err := c.formRequest(ctx, rel, url.Values{}, "PUT", body)
 	if err != nil {
 		return err
 	}
 
 	req.Header["auth_token"] = []string{authToken}
 
-	err = c.doRequest(req, nil)
+	err = c.doRequest("UpdateWordList", req, nil)
 
 	return err
 }
 
 // GetWordList retrieves a WordList given it's permalink.
 func (c *Client) GetWordList(authToken, permalink string) (WordList, error) {
+	return c.GetWordListContext(context.Background(), authToken, permalink)
+}
+
+// GetWordListContext is like GetWordList, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetWordListContext(ctx context.Context, authToken, permalink string) (WordList, error) {
 	if authToken == "" || permalink == "" {
 		return WordList{}, errors.New("empty auth token  or permalink not allowed")
 	}
 
 	rel := &url.URL{Path: "wordList.json/" + permalink}
 
-	req, err := c.formRequest(rel, url.Values{}, "GET")
+	req, err := c.formRequest(ctx, rel, url.Values{}, "GET")
 	if err != nil {
 		return WordList{}, err
 	}
@@ -101,13 +120,19 @@ func (c *Client) GetWordList(authToken, permalink string) (WordList, error) {
 	req.Header["auth_token"] = []string{authToken}
 
 	var results WordList
-	err = c.doRequest(req, &results)
+	err = c.doRequest("GetWordList", req, &results)
 
 	return results, err
 }
 
 // AddWordsToWordList adds words to a WordList.
 func (c *Client) AddWordsToWordList(authToken, permalink string, words []string) error {
+	return c.AddWordsToWordListContext(context.Background(), authToken, permalink, words)
+}
+
+// AddWordsToWordListContext is like AddWordsToWordList, but carries a context
+// for cancellation and deadlines.
+func (c *Client) AddWordsToWordListContext(ctx context.Context, authToken, permalink string, words []string) error {
 	if authToken == "" || permalink == "" {
 		return errors.New("empty auth token  or permalink not allowed")
 	}
@@ -126,19 +151,25 @@ func (c *Client) AddWordsToWordList(authToken, permalink string, words []string)
 	}
 
 	body := bytes.NewBuffer(marshalledWords)
-	req, err := c.formRequest(rel, url.Values{}, "POST", body)
+	req, err := c.formRequest(ctx, rel, url.Values{}, "POST", body)
 	if err != nil {
 		return err
 	}
 
 	req.Header["auth_token"] = []string{authToken}
 
-	return c.doRequest(req, nil)
+	return c.doRequest("AddWordsToWordList", req, nil)
 }
 
 // GetWordListWords retrieves words from a WordList. Note that this may not be
 // all of the words in the list, as determined by the "skip" and "limit" options.
 func (c *Client) GetWordListWords(authToken, permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return c.GetWordListWordsContext(context.Background(), authToken, permalink, options...)
+}
+
+// GetWordListWordsContext is like GetWordListWords, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetWordListWordsContext(ctx context.Context, authToken, permalink string, options ...QueryOption) ([]WordListWord, error) {
 	if authToken == "" || permalink == "" {
 		return []WordListWord{}, errors.New("empty auth token  or permalink not allowed")
 	}
@@ -153,11 +184,11 @@ func (c *Client) GetWordListWords(authToken, permalink string, options ...QueryO
 		"limit":     []string{"100"},
 	}
 
-	for _, option := range options {
-		option(&q)
+	if err := c.applyOptions("GetWordListWords", &q, options); err != nil {
+		return []WordListWord{}, err
 	}
 
-	req, err := c.formRequest(rel, q, "GET")
+	req, err := c.formRequest(ctx, rel, q, "GET")
 	if err != nil {
 		return []WordListWord{}, err
 	}
@@ -165,14 +196,51 @@ func (c *Client) GetWordListWords(authToken, permalink string, options ...QueryO
 	req.Header["auth_token"] = []string{authToken}
 
 	var results []WordListWord
-	err = c.doRequest(req, &results)
+	err = c.doRequest("GetWordListWords", req, &results)
 
 	return results, err
 }
 
+// WordListWordsIter returns a Pager over the words in a WordList, which
+// requests pages from GetWordListWords as needed. The SortBy and SortOrder
+// options are respected, while Skip and Limit set the starting offset and page
+// size.
+func (c *Client) WordListWordsIter(authToken, permalink string, options ...QueryOption) *Pager[WordListWord] {
+	return c.WordListWordsIterContext(context.Background(), authToken, permalink, options...)
+}
+
+// WordListWordsIterContext is like WordListWordsIter, but carries a context for
+// cancellation and deadlines.
+func (c *Client) WordListWordsIterContext(ctx context.Context, authToken, permalink string, options ...QueryOption) *Pager[WordListWord] {
+	fetch := func(ctx context.Context, skip, limit int64) ([]WordListWord, int64, error) {
+		words, err := c.GetWordListWordsContext(ctx, authToken, permalink, pageOptions(options, skip, limit)...)
+		return words, 0, err
+	}
+
+	return newPager(ctx, fetch, false, options)
+}
+
+// AllWordListWords retrieves every word in a WordList, requesting as many
+// pages as needed. The SortBy and SortOrder options are respected.
+func (c *Client) AllWordListWords(authToken, permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return c.AllWordListWordsContext(context.Background(), authToken, permalink, options...)
+}
+
+// AllWordListWordsContext is like AllWordListWords, but carries a context for
+// cancellation and deadlines.
+func (c *Client) AllWordListWordsContext(ctx context.Context, authToken, permalink string, options ...QueryOption) ([]WordListWord, error) {
+	return c.WordListWordsIterContext(ctx, authToken, permalink, options...).Collect()
+}
+
 // DeleteWordsFromWordList deletes specific words from a WordList if they are
 // present.
 func (c *Client) DeleteWordsFromWordList(authToken, permalink string, words []string) error {
+	return c.DeleteWordsFromWordListContext(context.Background(), authToken, permalink, words)
+}
+
+// DeleteWordsFromWordListContext is like DeleteWordsFromWordList, but carries a
+// context for cancellation and deadlines.
+func (c *Client) DeleteWordsFromWordListContext(ctx context.Context, authToken, permalink string, words []string) error {
 	if authToken == "" || permalink == "" {
 		return errors.New("empty auth token  or permalink not allowed")
 	}
@@ -191,12 +259,12 @@ func (c *Client) DeleteWordsFromWordList(authToken, permalink string, words []st
 	}
 
 	body := bytes.NewBuffer(marshalledWords)
-	req, err := c.formRequest(rel, url.Values{}, "POST", body)
+	req, err := c.formRequest(ctx, rel, url.Values{}, "POST", body)
 	if err != nil {
 		return err
 	}
 
 	req.Header["auth_token"] = []string{authToken}
 
-	return c.doRequest(req, nil)
+	return c.doRequest("DeleteWordsFromWordList", req, nil)
 }
@@ -1,7 +1,11 @@
 package wordnik
 
 import (
+	"fmt"
 	"testing"
+	"time"
+
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
 )
 
 // tests GetWordList, UpdateWordList, and DeleteWordList
@@ -121,3 +125,67 @@ func TestWordListWordsFuncs(t *testing.T) {
 		t.Error("unexpected error in DeleteWordList: " + err.Error())
 	}
 }
+
+// Helper function for testing which returns a Client and auth token for a
+// wordniktest.Server user with lists word lists, the first of which holds
+// words words, w000 onwards.
+func newListsClient(t *testing.T, lists, words int) (*Client, *wordniktest.Server, string) {
+	user := wordniktest.User{ID: 1, Username: "lister", Password: "pass"}
+	for i := 0; i < lists; i++ {
+		user.WordLists = append(user.WordLists, wordniktest.WordList{Name: fmt.Sprintf("list %d", i)})
+	}
+	for i := 0; i < words; i++ {
+		user.WordLists[0].Words = append(user.WordLists[0].Words, fmt.Sprintf("w%03d", i))
+	}
+
+	cl, srv := newFixtureClient(t, wordniktest.Fixtures{Users: []wordniktest.User{user}}, wordniktest.DefaultAPIKey)
+
+	auth, err := cl.AuthenticatePOST("lister", "pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cl, srv, auth.Token
+}
+
+func TestAllWordListWords(t *testing.T) {
+	cl, srv, token := newListsClient(t, 1, 250)
+	limiter := NewRateLimiter(100, time.Hour)
+	cl.SetRateLimiter(limiter)
+
+	lists, err := cl.GetWordListsForUser(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	words, err := cl.AllWordListWords(token, lists[0].Permalink, SortBy("alpha"), SortOrder("desc"), Limit(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(words) != 250 || words[0].Word != "w249" || words[249].Word != "w000" {
+		t.Errorf("got %d words, expected w249 to w000", len(words))
+	}
+
+	var pages int
+	for _, req := range srv.Requests() {
+		if req.Path == "wordList.json/"+lists[0].Permalink+"/words" {
+			pages++
+			if req.Query.Get("sortOrder") != "desc" {
+				t.Errorf("expected sortOrder to be sent with every page, got %v", req.Query)
+			}
+		}
+	}
+	if pages != 3 {
+		t.Errorf("expected 3 pages, got %d", pages)
+	}
+	if remaining := limiter.Budget().Remaining; remaining != 96 {
+		t.Errorf("expected every page to take from the rate limit budget, %d remaining", remaining)
+	}
+
+	it := cl.WordListWordsIter(token, lists[0].Permalink).PageSize(10)
+	for i := 0; i < 15 && it.Next(); i++ {
+	}
+	if it.Item().Word != "w014" {
+		t.Errorf("got %q from iterator, expected w014", it.Item().Word)
+	}
+}
@@ -2,6 +2,7 @@ package wordnik
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/url"
@@ -10,6 +11,12 @@ import (
 // CreateWordList attempts to create a word list for a given account. Returns the
 // list as a WordList object if successful.
 func (c *Client) CreateWordList(authToken string, list WordList) (WordList, error) {
+	return c.CreateWordListContext(context.Background(), authToken, list)
+}
+
+// CreateWordListContext is like CreateWordList, but carries a context for
+// cancellation and deadlines.
+func (c *Client) CreateWordListContext(ctx context.Context, authToken string, list WordList) (WordList, error) {
 	if authToken == "" {
 		return WordList{}, errors.New("empty auth token not allowed")
 	}
@@ -21,7 +28,7 @@ func (c *Client) CreateWordList(authToken string, list WordList) (WordList, erro
 	}
 
 	body := bytes.NewBuffer(marshalledList)
-	req, err := c.formRequest(rel, url.Values{}, "POST", body)
+	req, err := c.formRequest(ctx, rel, url.Values{}, "POST", body)
 	if err != nil {
 		return WordList{}, err
 	}
@@ -29,7 +36,7 @@ func (c *Client) CreateWordList(authToken string, list WordList) (WordList, erro
 	req.Header["auth_token"] = []string{authToken}
 
 	var results WordList
-	err = c.doRequest(req, &results)
+	err = c.doRequest("CreateWordList", req, &results)
 
 	return results, err
 }
@@ -0,0 +1,266 @@
+// Package wordnikprom exposes metrics about a wordnik.Client in the Prometheus
+// text exposition format, without depending on the Prometheus client library.
+//
+// A Collector is installed as the Client's Instrumentation and mounted as an
+// http.Handler:
+//
+//	collector := wordnikprom.NewCollector()
+//	cl.SetInstrumentation(collector)
+//	collector.PollTokenStatus(ctx, cl, time.Minute, nil)
+//	http.Handle("/metrics", collector)
+//
+// A Client reports to a single Instrumentation, so to keep tracing alongside
+// the Collector, combine them with wordnik.MultiInstrumentation:
+//
+//	cl.SetInstrumentation(wordnik.MultiInstrumentation(tracing, collector))
+package wordnikprom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rhallora-heidelberg/go-wordnik"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the latency histogram
+// buckets.
+var DefaultBuckets = []float64{0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Collector records metrics about API calls and the API key's quota. It
+// implements wordnik.Instrumentation and http.Handler, and is safe for
+// concurrent use.
+type Collector struct {
+	buckets []float64
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+
+	tokenStatus       wordnik.APITokenStatus
+	tokenStatusSet    bool
+	tokenStatusErrors int64
+}
+
+// endpointMetrics holds the metrics of a single endpoint.
+type endpointMetrics struct {
+	requests  int64
+	cacheHits int64
+	retries   int64
+	errors    map[string]int64 // by status code
+	counts    []int64          // per bucket, not cumulative
+	sum       float64
+}
+
+// NewCollector creates a Collector using DefaultBuckets for its latency
+// histograms.
+func NewCollector() *Collector {
+	return NewCollectorWithBuckets(DefaultBuckets)
+}
+
+// NewCollectorWithBuckets creates a Collector whose latency histograms use the
+// given bucket upper bounds, in seconds.
+func NewCollectorWithBuckets(buckets []float64) *Collector {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	return &Collector{
+		buckets:   sorted,
+		endpoints: map[string]*endpointMetrics{},
+	}
+}
+
+// StartCall implements wordnik.Instrumentation.
+func (c *Collector) StartCall(ctx context.Context, call wordnik.CallInfo) (context.Context, func(wordnik.CallResult)) {
+	return ctx, func(res wordnik.CallResult) {
+		c.record(call.Endpoint, res)
+	}
+}
+
+// record adds the result of a call to endpoint to the metrics.
+func (c *Collector) record(endpoint string, res wordnik.CallResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, ok := c.endpoints[endpoint]
+	if !ok {
+		m = &endpointMetrics{
+			errors: map[string]int64{},
+			counts: make([]int64, len(c.buckets)+1),
+		}
+		c.endpoints[endpoint] = m
+	}
+
+	m.requests++
+	m.retries += int64(res.Retries)
+	if res.CacheHit {
+		m.cacheHits++
+	}
+
+	if res.Err != nil {
+		status := "none"
+		if res.StatusCode != 0 {
+			status = strconv.Itoa(res.StatusCode)
+		}
+		m.errors[status]++
+	}
+
+	seconds := res.Duration.Seconds()
+	m.sum += seconds
+	m.counts[sort.SearchFloat64s(c.buckets, seconds)]++
+}
+
+// UpdateTokenStatus sets the quota gauges from status.
+func (c *Collector) UpdateTokenStatus(status wordnik.APITokenStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokenStatus = status
+	c.tokenStatusSet = true
+}
+
+// PollTokenStatus fetches the API key's status from cl immediately and then
+// every interval, until ctx is done, and updates the quota gauges. Errors are
+// counted, and passed to onError if it is non-nil. It returns an error, without
+// polling, if interval isn't positive.
+func (c *Collector) PollTokenStatus(ctx context.Context, cl *wordnik.Client, interval time.Duration, onError func(error)) error {
+	if interval <= 0 {
+		return errors.New("wordnikprom: token status poll interval must be positive")
+	}
+
+	poll := func() {
+		status, err := cl.GetAPITokenStatusContext(ctx)
+		if err != nil {
+			c.mu.Lock()
+			c.tokenStatusErrors++
+			c.mu.Unlock()
+
+			if onError != nil {
+				onError(err)
+			}
+			return
+		}
+
+		c.UpdateTokenStatus(status)
+	}
+
+	go func() {
+		poll()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				poll()
+			}
+		}
+	}()
+	return nil
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format to w.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var b strings.Builder
+
+	names := make([]string, 0, len(c.endpoints))
+	for name := range c.endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writeHeader(&b, "wordnik_requests_total", "counter", "API calls made, by endpoint.")
+	for _, name := range names {
+		writeSample(&b, "wordnik_requests_total", labels("endpoint", name), float64(c.endpoints[name].requests))
+	}
+
+	writeHeader(&b, "wordnik_errors_total", "counter", "API calls which failed, by endpoint and status code.")
+	for _, name := range names {
+		m := c.endpoints[name]
+		statuses := make([]string, 0, len(m.errors))
+		for status := range m.errors {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+
+		for _, status := range statuses {
+			writeSample(&b, "wordnik_errors_total", labels("endpoint", name, "status", status), float64(m.errors[status]))
+		}
+	}
+
+	writeHeader(&b, "wordnik_retries_total", "counter", "Retried HTTP attempts, by endpoint.")
+	for _, name := range names {
+		writeSample(&b, "wordnik_retries_total", labels("endpoint", name), float64(c.endpoints[name].retries))
+	}
+
+	writeHeader(&b, "wordnik_cache_hits_total", "counter", "API calls served from the cache, by endpoint. Divide by wordnik_requests_total for the hit ratio.")
+	for _, name := range names {
+		writeSample(&b, "wordnik_cache_hits_total", labels("endpoint", name), float64(c.endpoints[name].cacheHits))
+	}
+
+	writeHeader(&b, "wordnik_request_duration_seconds", "histogram", "Latency of API calls, by endpoint.")
+	for _, name := range names {
+		m := c.endpoints[name]
+		var cumulative int64
+		for i, bound := range c.buckets {
+			cumulative += m.counts[i]
+			le := strconv.FormatFloat(bound, 'g', -1, 64)
+			writeSample(&b, "wordnik_request_duration_seconds_bucket", labels("endpoint", name, "le", le), float64(cumulative))
+		}
+		cumulative += m.counts[len(c.buckets)]
+		writeSample(&b, "wordnik_request_duration_seconds_bucket", labels("endpoint", name, "le", "+Inf"), float64(cumulative))
+		writeSample(&b, "wordnik_request_duration_seconds_sum", labels("endpoint", name), m.sum)
+		writeSample(&b, "wordnik_request_duration_seconds_count", labels("endpoint", name), float64(cumulative))
+	}
+
+	if c.tokenStatusSet {
+		writeHeader(&b, "wordnik_remaining_calls", "gauge", "Calls remaining in the API key's quota, as of the last status poll.")
+		writeSample(&b, "wordnik_remaining_calls", "", float64(c.tokenStatus.RemainingCalls))
+
+		writeHeader(&b, "wordnik_resets_in_milliseconds", "gauge", "Time until the API key's quota resets, as of the last status poll.")
+		writeSample(&b, "wordnik_resets_in_milliseconds", "", float64(c.tokenStatus.ResetsInMillis))
+	}
+
+	writeHeader(&b, "wordnik_token_status_errors_total", "counter", "Failed API key status polls.")
+	writeSample(&b, "wordnik_token_status_errors_total", "", float64(c.tokenStatusErrors))
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeSample(b *strings.Builder, name, labels string, value float64) {
+	fmt.Fprintf(b, "%s%s %s\n", name, labels, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// labels formats alternating label names and values, escaping the values.
+func labels(pairs ...string) string {
+	var parts []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, pairs[i]+`="`+labelEscaper.Replace(pairs[i+1])+`"`)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
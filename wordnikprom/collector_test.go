@@ -0,0 +1,97 @@
+package wordnikprom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rhallora-heidelberg/go-wordnik"
+)
+
+func TestCollector(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/apiTokenStatus"):
+			w.Write([]byte(`{"remainingCalls":4999,"resetsInMillis":120000}`))
+		case strings.Contains(r.URL.Path, "notaword"):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer srv.Close()
+
+	collector := NewCollectorWithBuckets([]float64{60, 0.000001})
+	cl, err := wordnik.NewClientWithOptions("abc",
+		wordnik.WithBaseURL(srv.URL),
+		wordnik.WithCache(wordnik.NewMemoryCache(10), time.Hour),
+		wordnik.WithInstrumentation(collector),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cl.GetDefinitions("cat")
+	cl.GetDefinitions("cat")
+	cl.GetDefinitions("notaword")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := collector.PollTokenStatus(ctx, cl, 0, nil); err == nil {
+		t.Error("expected error for zero poll interval")
+	}
+	if err := collector.PollTokenStatus(ctx, cl, time.Hour, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		collector.mu.Lock()
+		polled := collector.tokenStatusSet
+		collector.mu.Unlock()
+		if polled || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	out := rec.Body.String()
+
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type %q", rec.Header().Get("Content-Type"))
+	}
+
+	for _, expected := range []string{
+		"# TYPE wordnik_requests_total counter\n",
+		`wordnik_requests_total{endpoint="GetDefinitions"} 3`,
+		`wordnik_requests_total{endpoint="GetAPITokenStatus"} 1`,
+		`wordnik_errors_total{endpoint="GetDefinitions",status="404"} 1`,
+		`wordnik_cache_hits_total{endpoint="GetDefinitions"} 1`,
+		`wordnik_request_duration_seconds_bucket{endpoint="GetDefinitions",le="60"} 3`,
+		`wordnik_request_duration_seconds_bucket{endpoint="GetDefinitions",le="+Inf"} 3`,
+		`wordnik_request_duration_seconds_count{endpoint="GetDefinitions"} 3`,
+		"wordnik_remaining_calls 4999\n",
+		"wordnik_resets_in_milliseconds 120000\n",
+		"wordnik_token_status_errors_total 0\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q:\n%s", expected, out)
+		}
+	}
+
+	// Buckets are sorted, so the tiny bucket comes first.
+	if strings.Index(out, `le="1e-06"`) > strings.Index(out, `le="60"`) {
+		t.Error("expected buckets in ascending order")
+	}
+}
+
+func TestLabelEscaping(t *testing.T) {
+	if got := labels("endpoint", "a\"b\\c\nd"); got != `{endpoint="a\"b\\c\nd"}` {
+		t.Errorf("got %s", got)
+	}
+}
@@ -0,0 +1,288 @@
+package wordniktest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// serveAccount serves the account.json endpoints. s.mu must be held.
+func (s *Server) serveAccount(w http.ResponseWriter, req *request, segments []string) {
+	switch {
+	case len(segments) == 2 && segments[0] == "authenticate" && (req.r.Method == "GET" || req.r.Method == "POST"):
+		password := req.query.Get("password")
+		if req.r.Method == "POST" {
+			password = string(req.body)
+		}
+
+		user, ok := s.users[segments[1]]
+		if !ok || user.Password != password {
+			writeError(w, http.StatusUnauthorized, "Invalid username or password")
+			return
+		}
+
+		s.nextToken++
+		token := "token-" + strconv.FormatInt(s.nextToken, 10)
+		s.tokens[token] = user
+		writeJSON(w, map[string]interface{}{
+			"token":         token,
+			"userId":        user.ID,
+			"userSignature": user.Username,
+		})
+	case len(segments) == 1 && segments[0] == "apiTokenStatus" && req.r.Method == "GET":
+		key := req.r.Header.Get("api_key")
+		resetsIn := s.windowStart.Add(quotaWindow).Sub(time.Now())
+		writeJSON(w, map[string]interface{}{
+			"valid":           true,
+			"token":           key,
+			"resetsInMillis":  resetsIn.Milliseconds(),
+			"remainingCalls":  s.quota - s.calls[key],
+			"expiresInMillis": 0,
+			"totalRequests":   s.calls[key],
+		})
+	case len(segments) == 1 && segments[0] == "user" && req.r.Method == "GET":
+		user, ok := s.user(w, req)
+		if !ok {
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"id":          user.ID,
+			"username":    user.Username,
+			"userName":    user.Username,
+			"email":       user.Email,
+			"displayName": user.DisplayName,
+			"status":      0,
+		})
+	case len(segments) == 1 && segments[0] == "wordLists" && req.r.Method == "GET":
+		user, ok := s.user(w, req)
+		if !ok {
+			return
+		}
+
+		lists := []WordList{}
+		for _, list := range s.lists {
+			if list.UserID == user.ID {
+				lists = append(lists, copyList(list))
+			}
+		}
+		sort.Slice(lists, func(i, j int) bool {
+			return lists[i].ID < lists[j].ID
+		})
+
+		start, end := page(req, len(lists), 50)
+		writeJSON(w, lists[start:end])
+	default:
+		notFound(w, "no such endpoint: %s", req.path)
+	}
+}
+
+// serveWordLists serves the wordLists.json endpoint, which creates lists.
+// s.mu must be held.
+func (s *Server) serveWordLists(w http.ResponseWriter, req *request, segments []string) {
+	if len(segments) != 0 || req.r.Method != "POST" {
+		notFound(w, "no such endpoint: %s", req.path)
+		return
+	}
+
+	user, ok := s.user(w, req)
+	if !ok {
+		return
+	}
+
+	var list WordList
+	if err := json.Unmarshal(req.body, &list); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid word list: "+err.Error())
+		return
+	}
+	if list.Name == "" {
+		writeError(w, http.StatusBadRequest, "word list name is required")
+		return
+	}
+
+	list.ID = 0
+	list.Permalink = ""
+	writeJSON(w, copyList(s.addList(user, list)))
+}
+
+// serveWordList serves the wordList.json endpoints, whose paths are
+// "wordList.json/{permalink}" and "wordList.json/{permalink}/{resource}".
+// s.mu must be held.
+func (s *Server) serveWordList(w http.ResponseWriter, req *request, segments []string) {
+	if len(segments) == 0 || len(segments) > 2 {
+		notFound(w, "no such endpoint: %s", req.path)
+		return
+	}
+
+	user, ok := s.user(w, req)
+	if !ok {
+		return
+	}
+
+	list, ok := s.lists[segments[0]]
+	if !ok {
+		notFound(w, "word list not found: %s", segments[0])
+		return
+	}
+	if list.UserID != user.ID {
+		writeError(w, http.StatusForbidden, "word list belongs to another user")
+		return
+	}
+
+	resource := ""
+	if len(segments) == 2 {
+		resource = segments[1]
+	}
+
+	switch {
+	case resource == "" && req.r.Method == "GET":
+		writeJSON(w, copyList(list))
+	case resource == "" && req.r.Method == "PUT":
+		var update WordList
+		if err := json.Unmarshal(req.body, &update); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid word list: "+err.Error())
+			return
+		}
+		if update.Name != "" {
+			list.Name = update.Name
+		}
+		if update.Type != "" {
+			list.Type = update.Type
+		}
+		list.Description = update.Description
+		list.UpdatedAt = timestamp()
+	case resource == "" && req.r.Method == "DELETE":
+		delete(s.lists, list.Permalink)
+	case resource == "words" && req.r.Method == "GET":
+		writeJSON(w, listWords(req, list))
+	case resource == "words" && req.r.Method == "POST":
+		words, ok := decodeWords(w, req)
+		if !ok {
+			return
+		}
+		for _, word := range words {
+			if !contains(list.Words, word) {
+				list.Words = append(list.Words, word)
+			}
+		}
+		list.UpdatedAt = timestamp()
+	case resource == "deleteWords" && req.r.Method == "POST":
+		words, ok := decodeWords(w, req)
+		if !ok {
+			return
+		}
+		var kept []string
+		for _, word := range list.Words {
+			if !contains(words, word) {
+				kept = append(kept, word)
+			}
+		}
+		list.Words = kept
+		list.UpdatedAt = timestamp()
+	default:
+		notFound(w, "no such endpoint: %s", req.path)
+	}
+}
+
+// addList stores list as belonging to user, filling in the fields set by the
+// API. s.mu must be held.
+func (s *Server) addList(user *User, list WordList) *WordList {
+	if list.ID == 0 {
+		s.nextListID++
+		list.ID = s.nextListID
+	} else if list.ID > s.nextListID {
+		s.nextListID = list.ID
+	}
+	if list.Permalink == "" {
+		list.Permalink = permalink(list.Name, list.ID)
+	}
+	if list.Type == "" {
+		list.Type = "PUBLIC"
+	}
+	if list.CreatedAt == "" {
+		list.CreatedAt = timestamp()
+	}
+
+	list.Username = user.Username
+	list.UserID = user.ID
+	list.Words = append([]string(nil), list.Words...)
+
+	s.lists[list.Permalink] = &list
+	return &list
+}
+
+// listWords applies the sortBy, sortOrder, skip and limit parameters to the
+// words of list.
+func listWords(req *request, list *WordList) []map[string]interface{} {
+	words := append([]string(nil), list.Words...)
+	if req.query.Get("sortBy") == "alpha" {
+		sort.Strings(words)
+	}
+	if req.query.Get("sortOrder") == "desc" {
+		for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+			words[i], words[j] = words[j], words[i]
+		}
+	}
+
+	results := []map[string]interface{}{}
+	start, end := page(req, len(words), 100)
+	for _, word := range words[start:end] {
+		results = append(results, map[string]interface{}{
+			"word":      word,
+			"username":  list.Username,
+			"userId":    list.UserID,
+			"createdAt": list.CreatedAt,
+		})
+	}
+	return results
+}
+
+// decodeWords decodes a request body of the form [{"word": "..."}, ...],
+// writing an error response if it's malformed.
+func decodeWords(w http.ResponseWriter, req *request) ([]string, bool) {
+	var values []struct {
+		Word string `json:"word"`
+	}
+	if err := json.Unmarshal(req.body, &values); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid words: "+err.Error())
+		return nil, false
+	}
+
+	words := make([]string, len(values))
+	for i, value := range values {
+		words[i] = value.Word
+	}
+	return words, true
+}
+
+// copyList returns a copy of list with NumberWordsInList filled in.
+func copyList(list *WordList) WordList {
+	c := *list
+	c.Words = append([]string(nil), list.Words...)
+	c.NumberWordsInList = int64(len(list.Words))
+	return c
+}
+
+// permalink derives a list's permalink from its name and ID, in the style of
+// the API, e.g. "my-words--12".
+func permalink(name string, id int64) string {
+	slug := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case r == ' ' || r == '-' || r == '_':
+			return '-'
+		}
+		return -1
+	}, name)
+
+	return slug + "--" + strconv.FormatInt(id, 10)
+}
+
+// timestamp returns the current time in the API's format.
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
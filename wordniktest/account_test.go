@@ -0,0 +1,141 @@
+package wordniktest_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	wordnik "github.com/rhallora-heidelberg/go-wordnik"
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
+)
+
+func TestServerAuthentication(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.DefaultFixtures())
+
+	_, err := cl.AuthenticateGET(wordniktest.DefaultUsername, "wrong")
+	if !wordnik.IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error for wrong password, got %v", err)
+	}
+
+	_, err = cl.GetUser("not-a-token")
+	if !wordnik.IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error for unknown token, got %v", err)
+	}
+
+	auth, err := cl.AuthenticatePOST(wordniktest.DefaultUsername, wordniktest.DefaultPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := cl.GetUser(auth.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Username != wordniktest.DefaultUsername || user.ID != auth.UserID {
+		t.Errorf("unexpected user: %+v", user)
+	}
+
+	srv.ExpireTokens()
+	_, err = cl.GetUser(auth.Token)
+	if !wordnik.IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error for expired token, got %v", err)
+	}
+}
+
+func TestServerWordListState(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.DefaultFixtures())
+
+	auth, err := cl.AuthenticateGET(wordniktest.DefaultUsername, wordniktest.DefaultPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := cl.CreateWordList(auth.Token, wordnik.WordList{Name: "Birds of Prey"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Permalink == "" || list.Username != wordniktest.DefaultUsername {
+		t.Errorf("unexpected created list: %+v", list)
+	}
+
+	if err := cl.AddWordsToWordList(auth.Token, list.Permalink, []string{"kestrel", "osprey", "harrier"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cl.DeleteWordsFromWordList(auth.Token, list.Permalink, []string{"osprey"}); err != nil {
+		t.Fatal(err)
+	}
+
+	words, err := cl.GetWordListWords(auth.Token, list.Permalink, wordnik.SortBy("alpha"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(words) != 2 || words[0].Word != "harrier" || words[1].Word != "kestrel" {
+		t.Errorf("unexpected words: %+v", words)
+	}
+
+	stored, ok := srv.WordList(list.Permalink)
+	if !ok || stored.NumberWordsInList != 2 {
+		t.Errorf("unexpected stored list: %+v", stored)
+	}
+
+	lists, err := cl.GetWordListsForUser(auth.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 2 {
+		t.Errorf("expected the seeded and created lists, got %d lists", len(lists))
+	}
+
+	if err := cl.DeleteWordList(auth.Token, list.Permalink); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cl.GetWordList(auth.Token, list.Permalink); !wordnik.IsNotFound(err) {
+		t.Errorf("expected not found error for deleted list, got %v", err)
+	}
+}
+
+func TestServerWordListOwnership(t *testing.T) {
+	fixtures := wordniktest.DefaultFixtures()
+	fixtures.Users = append(fixtures.Users, wordniktest.User{ID: 2002, Username: "other", Password: "secret"})
+	_, cl := newServer(t, fixtures)
+
+	auth, err := cl.AuthenticateGET("other", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cl.GetWordList(auth.Token, "favorites--1")
+	apiErr, ok := err.(*wordnik.APIError)
+	if !ok || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected 403 error for another user's list, got %v", err)
+	}
+}
+
+func TestServerConcurrentWordLists(t *testing.T) {
+	_, cl := newServer(t, wordniktest.DefaultFixtures())
+
+	auth, err := cl.AuthenticateGET(wordniktest.DefaultUsername, wordniktest.DefaultPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cl.CreateWordList(auth.Token, wordnik.WordList{Name: "concurrent"}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	lists, err := cl.GetWordListsForUser(auth.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 11 {
+		t.Errorf("expected 11 lists, got %d", len(lists))
+	}
+}
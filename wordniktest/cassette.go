@@ -0,0 +1,374 @@
+package wordniktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CassetteVersion is the version of the cassette file format written by
+// Cassette. Files with a different version are rejected when loaded.
+const CassetteVersion = 1
+
+// redacted replaces credentials in recorded interactions.
+const redacted = "REDACTED"
+
+// secretParams are the query parameters and headers scrubbed from recordings.
+var secretParams = []string{"api_key", "auth_token", "password"}
+
+// ErrNoInteraction is returned, wrapped, by a replaying Cassette for requests
+// which weren't recorded. It has a Retryable method reporting false, so that
+// a wordnik.Client doesn't retry requests which can never succeed.
+var ErrNoInteraction error = permanentError("wordniktest: no recorded interaction")
+
+// permanentError is an error which a wordnik.RetryPolicy won't retry.
+type permanentError string
+
+func (e permanentError) Error() string { return string(e) }
+
+// Retryable reports false.
+func (permanentError) Retryable() bool { return false }
+
+// CassetteMode selects whether a Cassette records or replays.
+type CassetteMode int
+
+const (
+	// Replay serves recorded responses without network access.
+	Replay CassetteMode = iota
+
+	// Record sends requests on to the real transport and records them.
+	Record
+)
+
+// Cassette is an http.RoundTripper which records API interactions to a file,
+// or replays them from one. Recordings have API keys, auth tokens and
+// passwords scrubbed. To use one with a wordnik Client:
+//
+//	cassette, err := wordniktest.NewCassette("testdata/definitions.json", wordniktest.Replay, nil)
+//	cl, err := wordnik.NewClientWithOptions(key, wordnik.WithHTTPClient(cassette.Client()))
+//
+// A Cassette is safe for concurrent use.
+type Cassette struct {
+	path      string
+	mode      CassetteMode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         map[*Interaction]bool
+	secrets      map[string]bool
+}
+
+// cassetteFile is the JSON format of a cassette file.
+type cassetteFile struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request with its credentials removed.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response with any credentials it contained redacted.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// NewCassette creates a Cassette for the file at path. In Replay mode the file
+// is loaded, and must exist. In Record mode, requests are sent with transport,
+// or http.DefaultTransport if it's nil, and the file is written by Save.
+func NewCassette(path string, mode CassetteMode, transport http.RoundTripper) (*Cassette, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	c := &Cassette{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+		used:      map[*Interaction]bool{},
+		secrets:   map[string]bool{},
+	}
+
+	if mode == Replay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("wordniktest: malformed cassette %s: %v", path, err)
+		}
+		if file.Version != CassetteVersion {
+			return nil, fmt.Errorf("wordniktest: cassette %s has version %d; expected %d", path, file.Version, CassetteVersion)
+		}
+		c.interactions = file.Interactions
+	}
+
+	return c, nil
+}
+
+// Client returns an http.Client which sends requests through the Cassette.
+func (c *Cassette) Client() *http.Client {
+	return &http.Client{Transport: c}
+}
+
+// Interactions returns the interactions recorded or loaded so far.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	interactions := make([]Interaction, len(c.interactions))
+	for i, interaction := range c.interactions {
+		interactions[i] = *interaction
+	}
+	return interactions
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.mode == Record {
+		return c.record(req, body)
+	}
+	return c.replay(req, body)
+}
+
+// record sends req with the real transport and stores the interaction.
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	for _, name := range secretParams {
+		// The client sets its credential headers with lowercase keys, which
+		// Header.Get wouldn't find.
+		for _, value := range req.Header[name] {
+			c.addSecret(value)
+		}
+		c.addSecret(req.Header.Get(name))
+		c.addSecret(req.URL.Query().Get(name))
+	}
+	if isAuthenticate(req.URL.Path) && req.Method == "POST" {
+		c.addSecret(string(body))
+	}
+	c.mu.Unlock()
+
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+
+	res, err := c.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Tokens handed out by the API become secrets for the rest of the
+	// recording.
+	var tokenBody struct {
+		Token string `json:"token"`
+	}
+	if json.Unmarshal(resBody, &tokenBody) == nil {
+		c.addSecret(tokenBody.Token)
+	}
+
+	header := http.Header{}
+	for name, values := range res.Header {
+		if name == "Date" || name == "Set-Cookie" {
+			continue
+		}
+		for _, value := range values {
+			header.Add(name, c.scrub(value))
+		}
+	}
+
+	c.interactions = append(c.interactions, &Interaction{
+		Request: c.recordRequest(req, body),
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     header,
+			Body:       c.scrub(string(resBody)),
+		},
+	})
+
+	return res, nil
+}
+
+// replay finds the recorded response for req. Interactions are used in the
+// order they were recorded, so that repeated requests can get different
+// responses; once every matching interaction is used, the last is repeated.
+func (c *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	recorded := c.recordRequest(req, body)
+
+	var match *Interaction
+	for _, interaction := range c.interactions {
+		if !sameRequest(interaction.Request, recorded) {
+			continue
+		}
+		match = interaction
+		if !c.used[interaction] {
+			break
+		}
+	}
+
+	if match == nil {
+		return nil, fmt.Errorf("%w for %s %s?%s", ErrNoInteraction, recorded.Method, recorded.Path, recorded.Query)
+	}
+	c.used[match] = true
+
+	header := match.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Save writes the recorded interactions to the Cassette's file, creating its
+// directory if needed. It does nothing in Replay mode.
+func (c *Cassette) Save() error {
+	if c.mode != Record {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Version: CassetteVersion, Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// recordRequest describes req for recording and matching, without its
+// credentials. c.mu must be held.
+func (c *Cassette) recordRequest(req *http.Request, body []byte) RecordedRequest {
+	query := req.URL.Query()
+	for _, name := range secretParams {
+		query.Del(name)
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  encodeSorted(query),
+		Body:   string(body),
+	}
+	if isAuthenticate(req.URL.Path) && req.Method == "POST" {
+		recorded.Body = redacted
+	}
+	if c.mode == Record {
+		recorded.Body = c.scrub(recorded.Body)
+	}
+	return recorded
+}
+
+// addSecret notes a credential to redact. c.mu must be held.
+func (c *Cassette) addSecret(secret string) {
+	if secret != "" && secret != redacted {
+		c.secrets[secret] = true
+	}
+}
+
+// scrub replaces every known credential in s. c.mu must be held.
+func (c *Cassette) scrub(s string) string {
+	for secret := range c.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	return s
+}
+
+// isAuthenticate reports whether path is the authentication endpoint, whose
+// POST body is a password.
+func isAuthenticate(path string) bool {
+	return strings.Contains(path, "account.json/authenticate/")
+}
+
+// encodeSorted encodes vals with both keys and values sorted, so that the
+// order of repeated values doesn't affect matching.
+func encodeSorted(vals url.Values) string {
+	for _, values := range vals {
+		sort.Strings(values)
+	}
+	return vals.Encode()
+}
+
+// sameRequest reports whether a and b match. Their queries are compared with
+// the items of comma-separated values sorted too, so that the order of e.g.
+// relationshipTypes=synonym,antonym doesn't affect matching.
+func sameRequest(a, b RecordedRequest) bool {
+	return a.Method == b.Method && a.Path == b.Path && a.Body == b.Body &&
+		canonicalQuery(a.Query) == canonicalQuery(b.Query)
+}
+
+// canonicalQuery sorts the items of each comma-separated value in query, as
+// encoded by encodeSorted.
+func canonicalQuery(query string) string {
+	vals, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+
+	for _, values := range vals {
+		for i, value := range values {
+			items := strings.Split(value, ",")
+			sort.Strings(items)
+			values[i] = strings.Join(items, ",")
+		}
+	}
+	return encodeSorted(vals)
+}
@@ -0,0 +1,228 @@
+package wordniktest_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	wordnik "github.com/rhallora-heidelberg/go-wordnik"
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
+)
+
+func cassetteClient(t *testing.T, cassette *wordniktest.Cassette, baseURL string) *wordnik.Client {
+	cl, err := wordnik.NewClientWithOptions(wordniktest.DefaultAPIKey, wordnik.WithBaseURL(baseURL), wordnik.WithHTTPClient(cassette.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cl
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "account.json")
+
+	srv := wordniktest.NewServer(wordniktest.DefaultFixtures())
+	baseURL := srv.BaseURL()
+
+	recorder, err := wordniktest.NewCassette(path, wordniktest.Record, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := cassetteClient(t, recorder, baseURL)
+
+	defs, err := cl.GetDefinitions("potato", wordnik.SourceDictionaries("all"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := cl.AuthenticatePOST(wordniktest.DefaultUsername, wordniktest.DefaultPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := cl.GetUser(auth.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cl.GetDefinitions("wombat"); !wordnik.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{wordniktest.DefaultAPIKey, wordniktest.DefaultPassword, auth.Token} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains secret %q:\n%s", secret, data)
+		}
+	}
+
+	player, err := wordniktest.NewCassette(path, wordniktest.Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl = cassetteClient(t, player, baseURL)
+
+	replayedDefs, err := cl.GetDefinitions("potato", wordnik.SourceDictionaries("all"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayedDefs) != len(defs) {
+		t.Errorf("expected %d replayed definitions, got %d", len(defs), len(replayedDefs))
+	}
+
+	replayedAuth, err := cl.AuthenticatePOST(wordniktest.DefaultUsername, "any password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayedUser, err := cl.GetUser(replayedAuth.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayedUser.ID != user.ID {
+		t.Errorf("expected replayed user %d, got %d", user.ID, replayedUser.ID)
+	}
+
+	if _, err := cl.GetDefinitions("wombat"); !wordnik.IsNotFound(err) {
+		t.Errorf("expected replayed not found error, got %v", err)
+	}
+}
+
+func TestCassetteScrubsEchoedCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "echo.json")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, tok := r.Header.Get("api_key"), r.Header.Get("auth_token")
+		w.Header().Set("X-Echo", key+" "+tok)
+		fmt.Fprintf(w, `{"echo":"key=%s tok=%s"}`, key, tok)
+	}))
+	defer srv.Close()
+
+	recorder, err := wordniktest.NewCassette(path, wordniktest.Record, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl, err := wordnik.NewClientWithOptions("SECRETKEY", wordnik.WithBaseURL(srv.URL+"/v4"), wordnik.WithHTTPClient(recorder.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cl.GetWordListWords("SECRETTOKEN", "list")
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"SECRETKEY", "SECRETTOKEN"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains secret %q:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), "key=REDACTED tok=REDACTED") {
+		t.Errorf("expected echoed credentials to be redacted:\n%s", data)
+	}
+}
+
+func TestCassetteUnmatchedRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(path, []byte(`{"version": 1, "interactions": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cassette, err := wordniktest.NewCassette(path, wordniktest.Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := cassetteClient(t, cassette, "http://wordnik.invalid/v4/")
+
+	_, err = cl.GetDefinitions("cat")
+	if !errors.Is(err, wordniktest.ErrNoInteraction) {
+		t.Errorf("expected ErrNoInteraction, got %v", err)
+	}
+}
+
+func TestCassetteMissNotRetried(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(path, []byte(`{"version": 1, "interactions": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cassette, err := wordniktest.NewCassette(path, wordniktest.Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := cassetteClient(t, cassette, "http://wordnik.invalid/v4/")
+	cl.SetRetryPolicy(wordnik.RetryPolicy{MaxAttempts: 3})
+
+	attempts := 0
+	cl.Use(func(next wordnik.Doer) wordnik.Doer {
+		return wordnik.DoerFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return next.Do(req)
+		})
+	})
+
+	if _, err := cl.GetDefinitions("cat"); !errors.Is(err, wordniktest.ErrNoInteraction) {
+		t.Errorf("expected ErrNoInteraction, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected a cassette miss to fail on the first attempt, got %d attempts", attempts)
+	}
+}
+
+func TestCassetteVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "old.json")
+	if err := os.WriteFile(path, []byte(`{"version": 0, "interactions": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := wordniktest.NewCassette(path, wordniktest.Replay, nil); err == nil {
+		t.Error("expected error for cassette with unsupported version")
+	}
+
+	if _, err := wordniktest.NewCassette(filepath.Join(t.TempDir(), "missing.json"), wordniktest.Replay, nil); err == nil {
+		t.Error("expected error for missing cassette")
+	}
+}
+
+func TestCassetteCommaSeparatedOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "related.json")
+
+	srv := wordniktest.NewServer(wordniktest.DefaultFixtures())
+	defer srv.Close()
+
+	recorder, err := wordniktest.NewCassette(path, wordniktest.Record, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := cassetteClient(t, recorder, srv.BaseURL())
+	if _, err := cl.GetRelatedWords("cat", wordnik.RelationshipTypes(wordnik.RelationshipTypeSynonym, wordnik.RelationshipTypeAntonym)); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := wordniktest.NewCassette(path, wordniktest.Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl = cassetteClient(t, player, srv.BaseURL())
+	if _, err := cl.GetRelatedWords("cat", wordnik.RelationshipTypes(wordnik.RelationshipTypeAntonym, wordnik.RelationshipTypeSynonym)); err != nil {
+		t.Errorf("expected comma-separated values in any order to match, got %v", err)
+	}
+	if _, err := cl.GetRelatedWords("cat", wordnik.RelationshipTypes(wordnik.RelationshipTypeSynonym)); !errors.Is(err, wordniktest.ErrNoInteraction) {
+		t.Errorf("expected different values not to match, got %v", err)
+	}
+}
@@ -0,0 +1,358 @@
+package wordniktest
+
+// Fixtures is the data served by a Server. The types mirror the JSON returned
+// by the Wordnik API, independently of the wordnik package, so that the fake
+// can be used from that package's own tests.
+type Fixtures struct {
+	// APIKeys lists the API keys the server accepts. If empty, any non-empty
+	// key is accepted.
+	APIKeys []string
+
+	// Quota is the number of calls each API key may make per hour before the
+	// server responds with 429 Too Many Requests. Zero means the API's
+	// standard quota of 15000 calls per hour.
+	Quota int64
+
+	Words         []Word
+	WordsOfTheDay []WordOfTheDay
+	Users         []User
+}
+
+// Word holds everything the server knows about a word.
+type Word struct {
+	Word string `json:"word"`
+
+	// CanonicalForm is the word returned instead when useCanonical is set,
+	// e.g. "cat" for "cats". If empty, the word is its own canonical form.
+	CanonicalForm string `json:"canonicalForm,omitempty"`
+
+	CorpusCount     int64 `json:"corpusCount"`
+	DictionaryCount int64 `json:"dictionaryCount"`
+
+	Definitions    []Definition    `json:"definitions,omitempty"`
+	Examples       []Example       `json:"examples,omitempty"`
+	RelatedWords   []RelatedWord   `json:"relatedWords,omitempty"`
+	Pronunciations []Pronunciation `json:"pronunciations,omitempty"`
+	Syllables      []Syllable      `json:"syllables,omitempty"`
+	Frequency      []Frequency     `json:"frequency,omitempty"`
+	Phrases        []Bigram        `json:"phrases,omitempty"`
+	Etymologies    []string        `json:"etymologies,omitempty"`
+	Audio          []AudioFile     `json:"audio,omitempty"`
+}
+
+// Definition of a word from a single source dictionary.
+type Definition struct {
+	Word             string `json:"word"`
+	Text             string `json:"text"`
+	PartOfSpeech     string `json:"partOfSpeech"`
+	SourceDictionary string `json:"sourceDictionary"`
+	AttributionText  string `json:"attributionText,omitempty"`
+}
+
+// Example usage of a word.
+type Example struct {
+	ExampleID int64  `json:"exampleId"`
+	Word      string `json:"word"`
+	Title     string `json:"title"`
+	Text      string `json:"text"`
+	Year      int64  `json:"year,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// RelatedWord groups words sharing a relationship with a word.
+type RelatedWord struct {
+	RelationshipType string   `json:"relationshipType"`
+	Words            []string `json:"words"`
+}
+
+// Pronunciation of a word in a given format, such as "IPA".
+type Pronunciation struct {
+	Raw     string `json:"raw"`
+	RawType string `json:"rawType"`
+	Seq     int64  `json:"seq"`
+}
+
+// Syllable of a hyphenated word.
+type Syllable struct {
+	Text string `json:"text"`
+	Seq  int64  `json:"seq"`
+	Type string `json:"type,omitempty"`
+}
+
+// Frequency of a word in a given year.
+type Frequency struct {
+	Year  int64 `json:"year"`
+	Count int64 `json:"count"`
+}
+
+// Bigram is a two-word phrase containing a word.
+type Bigram struct {
+	Gram1 string  `json:"gram1"`
+	Gram2 string  `json:"gram2"`
+	Count int64   `json:"count"`
+	Mi    float64 `json:"mi"`
+	Wlmi  float64 `json:"wlmi"`
+}
+
+// AudioFile describes an audio pronunciation of a word.
+type AudioFile struct {
+	ID        int64   `json:"id"`
+	Word      string  `json:"word"`
+	FileURL   string  `json:"fileUrl"`
+	AudioType string  `json:"audioType"`
+	CreatedBy string  `json:"createdBy"`
+	Duration  float64 `json:"duration"`
+}
+
+// WordOfTheDay for a given date.
+type WordOfTheDay struct {
+	ID          int64              `json:"id"`
+	Word        string             `json:"word"`
+	PublishDate string             `json:"publishDate"`
+	Note        string             `json:"note"`
+	Definitions []SimpleDefinition `json:"definitions"`
+}
+
+// SimpleDefinition as used by WordOfTheDay.
+type SimpleDefinition struct {
+	Text         string `json:"text"`
+	Source       string `json:"source"`
+	PartOfSpeech string `json:"partOfSpeech"`
+}
+
+// User is an account which can authenticate and own word lists.
+type User struct {
+	ID          int64  `json:"id"`
+	Username    string `json:"username"`
+	Password    string `json:"-"`
+	Email       string `json:"email"`
+	DisplayName string `json:"displayName"`
+
+	WordLists []WordList `json:"-"`
+}
+
+// WordList owned by a User. Words are listed in the order they were added.
+type WordList struct {
+	ID                int64  `json:"id,omitempty"`
+	Permalink         string `json:"permalink,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Description       string `json:"description,omitempty"`
+	Type              string `json:"type,omitempty"`
+	Username          string `json:"username,omitempty"`
+	UserID            int64  `json:"userId,omitempty"`
+	CreatedAt         string `json:"createdAt,omitempty"`
+	UpdatedAt         string `json:"updatedAt,omitempty"`
+	NumberWordsInList int64  `json:"numberWordsInList"`
+
+	Words []string `json:"-"`
+}
+
+// Default credentials present in DefaultFixtures.
+const (
+	DefaultAPIKey   = "wordniktest-key"
+	DefaultUsername = "wordniktest"
+	DefaultPassword = "wordniktest-pass"
+)
+
+// DefaultFixtures returns a small dictionary, a few words of the day, and one
+// user (DefaultUsername, with DefaultPassword) owning one word list. It is
+// enough to exercise every endpoint.
+func DefaultFixtures() Fixtures {
+	return Fixtures{
+		APIKeys: []string{DefaultAPIKey},
+		Words: []Word{
+			{
+				Word:            "cat",
+				CorpusCount:     95400,
+				DictionaryCount: 12,
+				Definitions: []Definition{
+					{Word: "cat", Text: "A small carnivorous mammal domesticated since early times as a catcher of rats and mice.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+					{Word: "cat", Text: "To hoist (an anchor) to the cathead.", PartOfSpeech: "verb-transitive", SourceDictionary: "ahd"},
+					{Word: "cat", Text: "A domesticated species of feline animal.", PartOfSpeech: "noun", SourceDictionary: "wiktionary"},
+				},
+				Syllables: []Syllable{{Text: "cat", Seq: 0, Type: "stress"}},
+				Etymologies: []string{
+					"<ety>[Middle English, from Old English <ets>catt</ets>, from Late Latin <ets>cattus</ets>.]</ety>",
+				},
+			},
+			{
+				Word:            "cats",
+				CanonicalForm:   "cat",
+				CorpusCount:     30210,
+				DictionaryCount: 2,
+				Definitions: []Definition{
+					{Word: "cats", Text: "plural of <xref>cat</xref>", PartOfSpeech: "noun-plural", SourceDictionary: "wiktionary"},
+				},
+			},
+			{
+				Word:            "dem",
+				CorpusCount:     78,
+				DictionaryCount: 3,
+				Definitions: []Definition{
+					{Word: "dem", Text: "Eye dialect spelling of them.", PartOfSpeech: "pronoun", SourceDictionary: "wiktionary"},
+				},
+			},
+			{
+				Word:            "doggish",
+				CorpusCount:     41,
+				DictionaryCount: 4,
+				Definitions: []Definition{
+					{Word: "doggish", Text: "Having the bad qualities of a dog; churlish; growling; brutal.", PartOfSpeech: "adjective", SourceDictionary: "webster"},
+					{Word: "doggish", Text: "Of or like a dog.", PartOfSpeech: "adjective", SourceDictionary: "ahd"},
+				},
+			},
+			{
+				Word:            "incondite",
+				CorpusCount:     12,
+				DictionaryCount: 4,
+				Definitions: []Definition{
+					{Word: "incondite", Text: "Badly put together; crude.", PartOfSpeech: "adjective", SourceDictionary: "ahd"},
+					{Word: "incondite", Text: "Badly put together; crude; unpolished.", PartOfSpeech: "adjective", SourceDictionary: "webster"},
+				},
+			},
+			{
+				Word:            "likely",
+				CorpusCount:     251307,
+				DictionaryCount: 9,
+				Definitions: []Definition{
+					{Word: "likely", Text: "Possessing or displaying the qualities or characteristics that make something probable.", PartOfSpeech: "adjective", SourceDictionary: "ahd"},
+				},
+				Audio: []AudioFile{
+					{ID: 7121, Word: "likely", FileURL: "https://static.wordnik.com/audio/likely-1.mp3", AudioType: "pronunciation", CreatedBy: "ahd", Duration: 0.71},
+					{ID: 7122, Word: "likely", FileURL: "https://static.wordnik.com/audio/likely-2.mp3", AudioType: "pronunciation", CreatedBy: "macmillan", Duration: 0.65},
+				},
+			},
+			{
+				Word:            "mad",
+				CorpusCount:     46321,
+				DictionaryCount: 10,
+				Definitions: []Definition{
+					{Word: "mad", Text: "Angry; resentful.", PartOfSpeech: "adjective", SourceDictionary: "ahd"},
+				},
+				RelatedWords: []RelatedWord{
+					{RelationshipType: "synonym", Words: []string{"angry", "furious", "insane"}},
+					{RelationshipType: "variant", Words: []string{"madde", "madd"}},
+					{RelationshipType: "antonym", Words: []string{"sane", "calm"}},
+				},
+			},
+			{
+				Word:            "orange",
+				CorpusCount:     52811,
+				DictionaryCount: 11,
+				Definitions: []Definition{
+					{Word: "orange", Text: "Any of several southeast Asian evergreen trees of the genus <spn>Citrus</spn>.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+					{Word: "orange", Text: "The color between red and yellow in the visible spectrum.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+				},
+				Syllables: []Syllable{{Text: "or", Seq: 0, Type: "stress"}, {Text: "ange", Seq: 1}},
+				Frequency: frequencies(1990, 2012, 400),
+				Phrases: []Bigram{
+					{Gram1: "orange", Gram2: "juice", Count: 812, Mi: 9.1, Wlmi: 17.2},
+					{Gram1: "agent", Gram2: "orange", Count: 203, Mi: 8.4, Wlmi: 13.9},
+					{Gram1: "orange", Gram2: "peel", Count: 95, Mi: 7.7, Wlmi: 12.1},
+				},
+				Etymologies: []string{
+					"<ety>[Middle English <ets>orenge</ets>, from Old French, from Old Provençal <ets>auranja</ets>, from Arabic <ets>nāranj</ets>, from Persian <ets>nārang</ets>, from Sanskrit <ets>nāraṅgaḥ</ets>, orange tree.]</ety>",
+				},
+			},
+			{
+				Word:            "potato",
+				CorpusCount:     24519,
+				DictionaryCount: 11,
+				Definitions: []Definition{
+					{Word: "potato", Text: "A perennial plant <spn>(Solanum tuberosum)</spn> native to the Andes.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+					{Word: "potato", Text: "The starchy tuber of this plant, eaten as a vegetable.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+					{Word: "potato", Text: "The sweet potato.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+					{Word: "potato", Text: "A plant tuber, eaten as a starchy vegetable.", PartOfSpeech: "noun", SourceDictionary: "wiktionary"},
+					{Word: "potato", Text: "The plant bearing potato tubers, <em>Solanum tuberosum</em>.", PartOfSpeech: "noun", SourceDictionary: "wiktionary"},
+					{Word: "potato", Text: "A hole in a sock.", PartOfSpeech: "noun", SourceDictionary: "wiktionary"},
+					{Word: "potato", Text: "A couch potato.", PartOfSpeech: "noun", SourceDictionary: "wiktionary"},
+					{Word: "potato", Text: "A plant of the genus Solanum; also, its tuber.", PartOfSpeech: "noun", SourceDictionary: "webster"},
+					{Word: "potato", Text: "The sweet potato, Ipomœa Batatas.", PartOfSpeech: "noun", SourceDictionary: "century"},
+					{Word: "potato", Text: "An annual native to the Andes.", PartOfSpeech: "noun", SourceDictionary: "wordnet"},
+					{Word: "potato", Text: "An edible tuber native to South America.", PartOfSpeech: "noun", SourceDictionary: "wordnet"},
+				},
+				Examples: []Example{
+					{ExampleID: 1012, Word: "potato", Title: "Kitchen Notes", Text: "She peeled a potato for the soup.", Year: 2009},
+				},
+			},
+			{
+				Word:            "recalcitrant",
+				CorpusCount:     3108,
+				DictionaryCount: 8,
+				Definitions: []Definition{
+					{Word: "recalcitrant", Text: "Marked by stubborn unwillingness to obey authority.", PartOfSpeech: "adjective", SourceDictionary: "ahd"},
+				},
+				Examples: []Example{
+					{ExampleID: 635971537, Word: "recalcitrant", Title: "The Daily Chronicle", Text: "The recalcitrant mule refused to move.", Year: 2011},
+					{ExampleID: 635971538, Word: "recalcitrant", Title: "Letters", Text: "A recalcitrant committee delayed the vote.", Year: 2008},
+				},
+			},
+			{
+				Word:            "thought",
+				CorpusCount:     410022,
+				DictionaryCount: 10,
+				Definitions: []Definition{
+					{Word: "thought", Text: "The act or process of thinking; cogitation.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+				},
+			},
+			{
+				Word:            "tomato",
+				CorpusCount:     18201,
+				DictionaryCount: 10,
+				Definitions: []Definition{
+					{Word: "tomato", Text: "A widely cultivated plant <spn>(Solanum lycopersicum)</spn> having edible fruit.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+				},
+				Pronunciations: []Pronunciation{
+					{Raw: "(tə-mā′tō)", RawType: "ahd-5", Seq: 0},
+					{Raw: "/təˈmeɪtoʊ/", RawType: "IPA", Seq: 0},
+					{Raw: "/təˈmɑːtəʊ/", RawType: "IPA", Seq: 1},
+					{Raw: "T AH0 M EY1 T OW2", RawType: "arpabet", Seq: 0},
+				},
+			},
+			{
+				Word:            "lairage",
+				CorpusCount:     9,
+				DictionaryCount: 2,
+				Definitions: []Definition{
+					{Word: "lairage", Text: "A place where cattle are housed or laid up.", PartOfSpeech: "noun", SourceDictionary: "century"},
+				},
+			},
+			{
+				Word:            "world",
+				CorpusCount:     982031,
+				DictionaryCount: 12,
+				Definitions: []Definition{
+					{Word: "world", Text: "The earth.", PartOfSpeech: "noun", SourceDictionary: "ahd"},
+				},
+			},
+		},
+		WordsOfTheDay: []WordOfTheDay{
+			{ID: 1, Word: "jacklight", PublishDate: "2016-03-03", Definitions: []SimpleDefinition{{Text: "A light used in hunting or fishing at night.", Source: "ahd", PartOfSpeech: "noun"}}},
+			{ID: 2, Word: "lairage", PublishDate: "2017-02-03", Definitions: []SimpleDefinition{{Text: "A place where cattle are housed or laid up.", Source: "century", PartOfSpeech: "noun"}}},
+			{ID: 3, Word: "farouche", PublishDate: "2017-04-10", Definitions: []SimpleDefinition{{Text: "Sullen or shy in company.", Source: "wiktionary", PartOfSpeech: "adjective"}}},
+			{ID: 4, Word: "alegar", PublishDate: "2017-05-11", Definitions: []SimpleDefinition{{Text: "Sour ale; vinegar made of ale.", Source: "century", PartOfSpeech: "noun"}}},
+			{ID: 5, Word: "carnassial", PublishDate: "2017-06-12", Definitions: []SimpleDefinition{{Text: "Adapted for tearing flesh.", Source: "ahd", PartOfSpeech: "adjective"}}},
+		},
+		Users: []User{
+			{
+				ID:          1001,
+				Username:    DefaultUsername,
+				Password:    DefaultPassword,
+				Email:       "wordniktest@example.com",
+				DisplayName: "Test User",
+				WordLists: []WordList{
+					{ID: 1, Permalink: "favorites--1", Name: "favorites", Type: "PRIVATE", Words: []string{"serendipity", "petrichor"}},
+				},
+			},
+		},
+	}
+}
+
+// frequencies returns one Frequency per year from start to end inclusive.
+func frequencies(start, end, count int64) []Frequency {
+	var fs []Frequency
+	for year := start; year <= end; year++ {
+		fs = append(fs, Frequency{Year: year, Count: count + year - start})
+	}
+	return fs
+}
@@ -0,0 +1,448 @@
+// Package wordniktest provides a fake Wordnik v4 API for testing code which
+// uses the wordnik package, without network access or an API key.
+//
+// A Server serves every endpoint the wordnik Client calls from a set of
+// Fixtures. Word lists and authentication tokens are stateful, so lists
+// created through the API can be read back, and errors can be injected with
+// Faults:
+//
+//	srv := wordniktest.NewServer(wordniktest.DefaultFixtures())
+//	defer srv.Close()
+//
+//	cl, err := wordnik.NewClientWithOptions(wordniktest.DefaultAPIKey, wordnik.WithBaseURL(srv.BaseURL()))
+package wordniktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultQuota is the number of calls allowed per hour when
+	// Fixtures.Quota is zero, matching the API's standard quota.
+	defaultQuota = 15000
+
+	quotaWindow = time.Hour
+)
+
+// Server is a fake Wordnik API. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	apiKeys     map[string]bool
+	quota       int64
+	calls       map[string]int64
+	windowStart time.Time
+
+	words         map[string]Word
+	wordsOfTheDay map[string]WordOfTheDay
+	users         map[string]*User
+	tokens        map[string]*User
+	lists         map[string]*WordList
+	nextListID    int64
+	nextToken     int64
+
+	faults   []*Fault
+	requests []Request
+	rand     *rand.Rand
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+
+	// Path is relative to the API base url, e.g. "word.json/cat/definitions".
+	Path string
+
+	Query url.Values
+	Body  []byte
+}
+
+// Fault makes a Server fail matching requests instead of serving them.
+type Fault struct {
+	// Method restricts the fault to requests with this HTTP method. If
+	// empty, any method matches.
+	Method string
+
+	// Path restricts the fault to request paths, relative to the API base
+	// url, which begin with Path, such as "word.json/" or
+	// "account.json/apiTokenStatus". If empty, any path matches.
+	Path string
+
+	// StatusCode is the status of the error response. If zero, the request
+	// is served normally after Delay.
+	StatusCode int
+
+	// Body is the error response body. If empty, a JSON error message is
+	// sent.
+	Body string
+
+	// RetryAfter, if positive, is sent as the Retry-After header in seconds.
+	RetryAfter time.Duration
+
+	// Delay is how long to wait before responding.
+	Delay time.Duration
+
+	// Times is the number of requests the fault applies to. Zero means every
+	// request, until the faults are cleared.
+	Times int
+}
+
+// NewServer starts a Server serving fixtures. The caller should call Close
+// when finished, to shut it down.
+func NewServer(fixtures Fixtures) *Server {
+	s := &Server{
+		quota:         fixtures.Quota,
+		calls:         map[string]int64{},
+		windowStart:   time.Now(),
+		words:         map[string]Word{},
+		wordsOfTheDay: map[string]WordOfTheDay{},
+		users:         map[string]*User{},
+		tokens:        map[string]*User{},
+		lists:         map[string]*WordList{},
+		rand:          rand.New(rand.NewSource(1)),
+	}
+
+	if s.quota <= 0 {
+		s.quota = defaultQuota
+	}
+
+	if len(fixtures.APIKeys) > 0 {
+		s.apiKeys = map[string]bool{}
+		for _, key := range fixtures.APIKeys {
+			s.apiKeys[key] = true
+		}
+	}
+
+	for _, word := range fixtures.Words {
+		s.AddWord(word)
+	}
+	for _, wotd := range fixtures.WordsOfTheDay {
+		s.AddWordOfTheDay(wotd)
+	}
+	for _, user := range fixtures.Users {
+		s.AddUser(user)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL returns the url to configure a wordnik Client with, ending in "/v4/".
+func (s *Server) BaseURL() string {
+	return s.URL + "/v4/"
+}
+
+// AddWord adds word to the dictionary, replacing any existing entry.
+func (s *Server) AddWord(word Word) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.words[word.Word] = word
+}
+
+// AddWordOfTheDay adds wotd, replacing any existing word for its
+// PublishDate, which should be in the format "yyyy-MM-dd".
+func (s *Server) AddWordOfTheDay(wotd WordOfTheDay) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.wordsOfTheDay[wotd.PublishDate] = wotd
+}
+
+// AddUser adds user, along with their word lists. Lists without a Permalink
+// are given one.
+func (s *Server) AddUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lists := user.WordLists
+	user.WordLists = nil
+	s.users[user.Username] = &user
+
+	for _, list := range lists {
+		s.addList(&user, list)
+	}
+}
+
+// WordList returns the word list with the given permalink, as currently
+// stored by the server.
+func (s *Server) WordList(permalink string) (WordList, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list, ok := s.lists[permalink]
+	if !ok {
+		return WordList{}, false
+	}
+
+	return copyList(list), true
+}
+
+// InjectFault adds a fault. Faults are matched in the order they were added.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// Requests returns the requests received so far, oldest first.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// ResetQuota starts a new quota window, restoring every API key's calls.
+func (s *Server) ResetQuota() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = map[string]int64{}
+	s.windowStart = time.Now()
+}
+
+// ExpireTokens invalidates every auth token issued so far, as if they had
+// expired. Requests using them fail with 401 Unauthorized.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens = map[string]*User{}
+}
+
+// serveHTTP records the request, applies faults, authenticates the API key
+// and dispatches to the endpoint handlers.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v4/")
+
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.Query(),
+		Body:   body,
+	})
+	fault := s.matchFault(r.Method, path)
+	s.mu.Unlock()
+
+	if fault != nil {
+		if fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if fault.StatusCode != 0 {
+			if fault.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter/time.Second)))
+			}
+			if fault.Body != "" {
+				w.WriteHeader(fault.StatusCode)
+				w.Write([]byte(fault.Body))
+			} else {
+				writeError(w, fault.StatusCode, http.StatusText(fault.StatusCode))
+			}
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.authorize(w, r, path) {
+		return
+	}
+
+	req := &request{r: r, path: path, body: body, query: r.URL.Query()}
+	segments := strings.Split(path, "/")
+	switch segments[0] {
+	case "word.json":
+		s.serveWord(w, req, segments[1:])
+	case "words.json":
+		s.serveWords(w, req, segments[1:])
+	case "account.json":
+		s.serveAccount(w, req, segments[1:])
+	case "wordList.json":
+		s.serveWordList(w, req, segments[1:])
+	case "wordLists.json":
+		s.serveWordLists(w, req, segments[1:])
+	default:
+		writeError(w, http.StatusNotFound, "no such endpoint: "+path)
+	}
+}
+
+// matchFault returns the first fault matching the request, using it up.
+// s.mu must be held.
+func (s *Server) matchFault(method, path string) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != method {
+			continue
+		}
+		if !strings.HasPrefix(path, f.Path) {
+			continue
+		}
+
+		matched := *f
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return &matched
+	}
+
+	return nil
+}
+
+// authorize checks the request's API key and counts it against the key's
+// quota, writing an error response if it's rejected. s.mu must be held.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, path string) bool {
+	key := r.Header.Get("api_key")
+	if key == "" {
+		key = r.URL.Query().Get("api_key")
+	}
+
+	if key == "" || (s.apiKeys != nil && !s.apiKeys[key]) {
+		writeError(w, http.StatusUnauthorized, "Invalid authentication credentials")
+		return false
+	}
+
+	if path == "account.json/apiTokenStatus" {
+		return true
+	}
+
+	now := time.Now()
+	if now.Sub(s.windowStart) >= quotaWindow {
+		s.calls = map[string]int64{}
+		s.windowStart = now
+	}
+
+	if s.calls[key] >= s.quota {
+		resetsIn := s.windowStart.Add(quotaWindow).Sub(now)
+		w.Header().Set("Retry-After", strconv.Itoa(int(resetsIn/time.Second)+1))
+		writeError(w, http.StatusTooManyRequests, "API rate limit exceeded")
+		return false
+	}
+
+	s.calls[key]++
+	return true
+}
+
+// request wraps an incoming request with its decoded parts.
+type request struct {
+	r     *http.Request
+	path  string
+	body  []byte
+	query url.Values
+}
+
+// intParam returns the integer query parameter name, or def if it's missing
+// or malformed.
+func (req *request) intParam(name string, def int64) int64 {
+	n, err := strconv.ParseInt(req.query.Get(name), 10, 64)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// boolParam returns the boolean query parameter name, or def if it's missing
+// or malformed.
+func (req *request) boolParam(name string, def bool) bool {
+	b, err := strconv.ParseBool(req.query.Get(name))
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// listParam splits the comma-separated query parameter name.
+func (req *request) listParam(name string) []string {
+	value := req.query.Get(name)
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// user returns the user whose token is in the auth_token header, writing an
+// error response if there is none. s.mu must be held.
+func (s *Server) user(w http.ResponseWriter, req *request) (*User, bool) {
+	token := req.r.Header.Get("auth_token")
+	user, ok := s.tokens[token]
+	if token == "" || !ok {
+		writeError(w, http.StatusUnauthorized, "Invalid auth token")
+		return nil, false
+	}
+	return user, true
+}
+
+// page applies the skip and limit parameters to n items, returning the range
+// to keep.
+func page(req *request, n int, defaultLimit int64) (int, int) {
+	skip := req.intParam("skip", 0)
+	limit := req.intParam("limit", defaultLimit)
+
+	start := int(skip)
+	if start < 0 {
+		start = 0
+	}
+	if start > n {
+		start = n
+	}
+
+	end := n
+	if limit >= 0 && start+int(limit) < n {
+		end = start + int(limit)
+	}
+	return start, end
+}
+
+// writeJSON writes v as a JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the API's format.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statusCode": statusCode,
+		"error":      http.StatusText(statusCode),
+		"message":    message,
+	})
+}
+
+// notFound writes a 404 response for the named resource.
+func notFound(w http.ResponseWriter, format string, args ...interface{}) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf(format, args...))
+}
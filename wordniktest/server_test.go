@@ -0,0 +1,162 @@
+package wordniktest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	wordnik "github.com/rhallora-heidelberg/go-wordnik"
+	"github.com/rhallora-heidelberg/go-wordnik/wordniktest"
+)
+
+func newServer(t *testing.T, fixtures wordniktest.Fixtures) (*wordniktest.Server, *wordnik.Client) {
+	srv := wordniktest.NewServer(fixtures)
+	t.Cleanup(srv.Close)
+
+	cl, err := wordnik.NewClientWithOptions(wordniktest.DefaultAPIKey, wordnik.WithBaseURL(srv.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, cl
+}
+
+func TestServerRejectsUnknownAPIKey(t *testing.T) {
+	srv, _ := newServer(t, wordniktest.DefaultFixtures())
+
+	cl, err := wordnik.NewClientWithOptions("wrong", wordnik.WithBaseURL(srv.BaseURL()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cl.GetDefinitions("cat")
+	if !wordnik.IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestServerSeededWords(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.Fixtures{})
+	srv.AddWord(wordniktest.Word{
+		Word: "quokka",
+		Definitions: []wordniktest.Definition{
+			{Word: "quokka", Text: "A small wallaby.", PartOfSpeech: "noun", SourceDictionary: "wiktionary"},
+		},
+	})
+
+	defs, err := cl.GetDefinitions("quokka")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(defs) != 1 || defs[0].Text != "A small wallaby." {
+		t.Errorf("unexpected definitions: %+v", defs)
+	}
+
+	_, err = cl.GetDefinitions("wombat")
+	if !wordnik.IsNotFound(err) {
+		t.Errorf("expected not found error for unknown word, got %v", err)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.DefaultFixtures())
+	srv.InjectFault(wordniktest.Fault{Path: "word.json/", StatusCode: http.StatusServiceUnavailable, Times: 2})
+
+	for i := 0; i < 2; i++ {
+		_, err := cl.GetDefinitions("cat")
+		var apiErr *wordnik.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Fatalf("call %d: expected 503 error, got %v", i+1, err)
+		}
+	}
+
+	if _, err := cl.GetDefinitions("cat"); err != nil {
+		t.Errorf("expected fault to be used up, got %v", err)
+	}
+}
+
+func TestServerFaultMatching(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.DefaultFixtures())
+	srv.InjectFault(wordniktest.Fault{Method: "GET", Path: "words.json/", StatusCode: http.StatusTooManyRequests, RetryAfter: 2 * time.Second})
+
+	if _, err := cl.GetDefinitions("cat"); err != nil {
+		t.Errorf("unexpected error for unmatched path: %v", err)
+	}
+
+	_, err := cl.SearchWords("cat")
+	var apiErr *wordnik.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 error, got %v", err)
+	}
+	if apiErr.RetryAfter != 2*time.Second {
+		t.Errorf("expected Retry-After of 2s, got %v", apiErr.RetryAfter)
+	}
+
+	srv.ClearFaults()
+	if _, err := cl.SearchWords("cat"); err != nil {
+		t.Errorf("unexpected error after clearing faults: %v", err)
+	}
+}
+
+func TestServerFaultDelay(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.DefaultFixtures())
+	srv.InjectFault(wordniktest.Fault{Delay: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := cl.GetDefinitionsContext(ctx, "cat")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestServerQuota(t *testing.T) {
+	fixtures := wordniktest.DefaultFixtures()
+	fixtures.Quota = 2
+	srv, cl := newServer(t, fixtures)
+
+	for i := 0; i < 2; i++ {
+		if _, err := cl.Hyphenation("orange"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	status, err := cl.GetAPITokenStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.RemainingCalls != 0 || status.TotalRequests != 2 {
+		t.Errorf("unexpected token status: %+v", status)
+	}
+
+	_, err = cl.Hyphenation("orange")
+	if !wordnik.IsRateLimited(err) {
+		t.Errorf("expected rate limited error, got %v", err)
+	}
+
+	srv.ResetQuota()
+	if _, err := cl.Hyphenation("orange"); err != nil {
+		t.Errorf("unexpected error after resetting quota: %v", err)
+	}
+}
+
+func TestServerRequests(t *testing.T) {
+	srv, cl := newServer(t, wordniktest.DefaultFixtures())
+
+	if _, err := cl.GetRelatedWords("mad", wordnik.LimitRelationshipType(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(reqs))
+	}
+	if reqs[0].Method != "GET" || reqs[0].Path != "word.json/mad/relatedWords" {
+		t.Errorf("unexpected request: %s %s", reqs[0].Method, reqs[0].Path)
+	}
+	if got := reqs[0].Query.Get("limitRelationshipType"); got != "1" {
+		t.Errorf("expected limitRelationshipType=1, got %q", got)
+	}
+}
@@ -0,0 +1,195 @@
+package wordniktest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// dictionaryOrder is the order in which source dictionaries are tried when
+// definitions are requested without naming one.
+var dictionaryOrder = []string{"ahd", "century", "wiktionary", "webster", "wordnet"}
+
+// serveWord serves the word.json endpoints, whose paths are
+// "word.json/{word}" and "word.json/{word}/{resource}". s.mu must be held.
+func (s *Server) serveWord(w http.ResponseWriter, req *request, segments []string) {
+	if req.r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if len(segments) == 0 || segments[0] == "" || len(segments) > 2 {
+		notFound(w, "no such endpoint: %s", req.path)
+		return
+	}
+
+	word, ok := s.lookup(segments[0], req.boolParam("useCanonical", false))
+	if !ok {
+		notFound(w, "word not found: %s", segments[0])
+		return
+	}
+
+	if len(segments) == 1 {
+		canonical := word.CanonicalForm
+		if canonical == "" {
+			canonical = word.Word
+		}
+		writeJSON(w, map[string]interface{}{
+			"id":            0,
+			"word":          word.Word,
+			"originalWord":  segments[0],
+			"canonicalForm": canonical,
+		})
+		return
+	}
+
+	switch segments[1] {
+	case "examples":
+		start, end := page(req, len(word.Examples), 5)
+		writeJSON(w, map[string]interface{}{"examples": nonNil(word.Examples[start:end])})
+	case "topExample":
+		if len(word.Examples) == 0 {
+			notFound(w, "no examples for %s", word.Word)
+			return
+		}
+		writeJSON(w, word.Examples[0])
+	case "definitions":
+		writeJSON(w, definitions(req, word))
+	case "relatedWords":
+		writeJSON(w, relatedWords(req, word))
+	case "pronunciations":
+		prons := []Pronunciation{}
+		for _, pron := range word.Pronunciations {
+			format := req.query.Get("typeFormat")
+			if format == "" || strings.EqualFold(format, pron.RawType) {
+				prons = append(prons, pron)
+			}
+		}
+		start, end := page(req, len(prons), 50)
+		writeJSON(w, prons[start:end])
+	case "hyphenation":
+		start, end := page(req, len(word.Syllables), 50)
+		writeJSON(w, nonNil(word.Syllables[start:end]))
+	case "frequency":
+		writeJSON(w, frequency(req, word))
+	case "phrases":
+		phrases := []Bigram{}
+		for _, phrase := range word.Phrases {
+			if phrase.Wlmi >= float64(req.intParam("wlmi", 0)) {
+				phrases = append(phrases, phrase)
+			}
+		}
+		start, end := page(req, len(phrases), 5)
+		writeJSON(w, phrases[start:end])
+	case "etymologies":
+		writeJSON(w, nonNil(word.Etymologies))
+	case "audio":
+		start, end := page(req, len(word.Audio), 50)
+		writeJSON(w, nonNil(word.Audio[start:end]))
+	default:
+		notFound(w, "no such endpoint: %s", req.path)
+	}
+}
+
+// lookup finds a word, or its canonical form if useCanonical is set. s.mu
+// must be held.
+func (s *Server) lookup(text string, useCanonical bool) (Word, bool) {
+	word, ok := s.words[text]
+	if ok && useCanonical && word.CanonicalForm != "" {
+		if canonical, ok := s.words[word.CanonicalForm]; ok {
+			return canonical, true
+		}
+	}
+	return word, ok
+}
+
+// definitions applies the sourceDictionaries, partOfSpeech and limit
+// parameters to the word's definitions. Unless sourceDictionaries is "all",
+// definitions come from the first listed dictionary which has any.
+func definitions(req *request, word Word) []Definition {
+	dictionaries := req.listParam("sourceDictionaries")
+	if len(dictionaries) == 0 {
+		dictionaries = dictionaryOrder
+	}
+	partsOfSpeech := req.listParam("partOfSpeech")
+
+	matches := func(def Definition, dictionary string) bool {
+		if dictionary != "all" && def.SourceDictionary != dictionary {
+			return false
+		}
+		return len(partsOfSpeech) == 0 || contains(partsOfSpeech, def.PartOfSpeech)
+	}
+
+	defs := []Definition{}
+	for _, dictionary := range dictionaries {
+		for _, def := range word.Definitions {
+			if matches(def, dictionary) {
+				defs = append(defs, def)
+			}
+		}
+		if len(defs) > 0 {
+			break
+		}
+	}
+
+	start, end := page(req, len(defs), 200)
+	return defs[start:end]
+}
+
+// relatedWords applies the relationshipTypes and limitRelationshipType
+// parameters to the word's related words.
+func relatedWords(req *request, word Word) []RelatedWord {
+	types := req.listParam("relationshipTypes")
+	limit := int(req.intParam("limitRelationshipType", 10))
+
+	related := []RelatedWord{}
+	for _, rel := range word.RelatedWords {
+		if len(types) > 0 && !contains(types, rel.RelationshipType) {
+			continue
+		}
+		if limit >= 0 && len(rel.Words) > limit {
+			rel.Words = rel.Words[:limit]
+		}
+		related = append(related, rel)
+	}
+	return related
+}
+
+// frequency applies the startYear and endYear parameters to the word's
+// frequencies.
+func frequency(req *request, word Word) map[string]interface{} {
+	startYear := req.intParam("startYear", 1800)
+	endYear := req.intParam("endYear", 2012)
+
+	freqs := []Frequency{}
+	var total int64
+	for _, freq := range word.Frequency {
+		if freq.Year >= startYear && freq.Year <= endYear {
+			freqs = append(freqs, freq)
+			total += freq.Count
+		}
+	}
+
+	return map[string]interface{}{
+		"word":             word.Word,
+		"totalCount":       total,
+		"unknownYearCount": 0,
+		"frequency":        freqs,
+	}
+}
+
+// contains reports whether list contains s, ignoring case.
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// nonNil returns an empty slice instead of nil, so that it's encoded as [].
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -0,0 +1,230 @@
+package wordniktest
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// wotdDate matches dates in the format the API accepts, "yyyy-MM-dd". Like the
+// API, it doesn't check that the date exists.
+var wotdDate = regexp.MustCompile(`^\d{4,}-\d{1,2}-\d{1,2}$`)
+
+// serveWords serves the words.json endpoints. s.mu must be held.
+func (s *Server) serveWords(w http.ResponseWriter, req *request, segments []string) {
+	if req.r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && segments[0] == "wordOfTheDay":
+		date := req.query.Get("date")
+		if !wotdDate.MatchString(date) {
+			writeError(w, http.StatusBadRequest, "Invalid date format: "+date)
+			return
+		}
+
+		wotd, ok := s.wordsOfTheDay[date]
+		if !ok {
+			notFound(w, "no word of the day for %s", date)
+			return
+		}
+		writeJSON(w, wotd)
+	case len(segments) == 2 && segments[0] == "search" && segments[1] != "":
+		s.serveSearch(w, req, segments[1])
+	case len(segments) == 1 && segments[0] == "reverseDictionary":
+		s.serveReverseDictionary(w, req)
+	case len(segments) == 1 && segments[0] == "randomWords":
+		words := s.randomWords(req)
+		start, end := page(req, len(words), 10)
+		writeJSON(w, wordObjects(words[start:end]))
+	case len(segments) == 1 && segments[0] == "randomWord":
+		words := s.randomWords(req)
+		if len(words) == 0 {
+			notFound(w, "no word matches the given constraints")
+			return
+		}
+		writeJSON(w, wordObjects(words[:1])[0])
+	default:
+		notFound(w, "no such endpoint: %s", req.path)
+	}
+}
+
+// serveSearch finds words containing query, exact matches first and then by
+// descending corpus count. s.mu must be held.
+func (s *Server) serveSearch(w http.ResponseWriter, req *request, query string) {
+	caseSensitive := req.boolParam("caseSensitive", true)
+	if !caseSensitive {
+		query = strings.ToLower(query)
+	}
+
+	var matches []Word
+	for _, word := range s.filterWords(req) {
+		text := word.Word
+		if !caseSensitive {
+			text = strings.ToLower(text)
+		}
+		if strings.Contains(text, query) {
+			matches = append(matches, word)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		exactI, exactJ := matches[i].Word == query, matches[j].Word == query
+		if exactI != exactJ {
+			return exactI
+		}
+		if matches[i].CorpusCount != matches[j].CorpusCount {
+			return matches[i].CorpusCount > matches[j].CorpusCount
+		}
+		return matches[i].Word < matches[j].Word
+	})
+
+	results := []map[string]interface{}{}
+	start, end := page(req, len(matches), 10)
+	for _, word := range matches[start:end] {
+		results = append(results, map[string]interface{}{
+			"word":       word.Word,
+			"count":      word.CorpusCount,
+			"lexicality": 0.0,
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"searchResults": results,
+		"totalResults":  len(matches),
+	})
+}
+
+// serveReverseDictionary finds definitions containing the query, ignoring
+// case. Results are limited to words beginning with findSenseForWord, if
+// given. s.mu must be held.
+func (s *Server) serveReverseDictionary(w http.ResponseWriter, req *request) {
+	query := strings.ToLower(req.query.Get("query"))
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	sense := req.query.Get("findSenseForWord")
+	include := req.listParam("includeSourceDictionaries")
+	exclude := req.listParam("excludeSourceDictionaries")
+
+	defs := []Definition{}
+	for _, word := range s.filterWords(req) {
+		if !strings.HasPrefix(word.Word, sense) {
+			continue
+		}
+
+		for _, def := range word.Definitions {
+			if len(include) > 0 && !contains(include, def.SourceDictionary) {
+				continue
+			}
+			if contains(exclude, def.SourceDictionary) {
+				continue
+			}
+			if strings.Contains(strings.ToLower(def.Text), query) {
+				defs = append(defs, def)
+			}
+		}
+	}
+
+	sort.SliceStable(defs, func(i, j int) bool {
+		return defs[i].Word < defs[j].Word
+	})
+	if req.query.Get("sortOrder") == "desc" {
+		for i, j := 0, len(defs)-1; i < j; i, j = i+1, j-1 {
+			defs[i], defs[j] = defs[j], defs[i]
+		}
+	}
+
+	start, end := page(req, len(defs), 10)
+	writeJSON(w, map[string]interface{}{
+		"results":      defs[start:end],
+		"totalResults": len(defs),
+	})
+}
+
+// randomWords returns the words matching the request's constraints in a
+// random order. s.mu must be held.
+func (s *Server) randomWords(req *request) []Word {
+	var words []Word
+	for _, word := range s.filterWords(req) {
+		if req.boolParam("hasDictionaryDef", false) && len(word.Definitions) == 0 {
+			continue
+		}
+		words = append(words, word)
+	}
+
+	s.rand.Shuffle(len(words), func(i, j int) {
+		words[i], words[j] = words[j], words[i]
+	})
+	return words
+}
+
+// filterWords returns the words, sorted alphabetically, which satisfy the
+// length, count and part of speech parameters shared by the words.json
+// endpoints. s.mu must be held.
+func (s *Server) filterWords(req *request) []Word {
+	within := func(n int64, minParam, maxParam string) bool {
+		min, max := req.intParam(minParam, 0), req.intParam(maxParam, -1)
+		return n >= min && (max < 0 || n <= max)
+	}
+
+	include := req.listParam("includePartOfSpeech")
+	exclude := req.listParam("excludePartOfSpeech")
+
+	var words []Word
+	for _, word := range s.words {
+		if !within(int64(len([]rune(word.Word))), "minLength", "maxLength") ||
+			!within(word.CorpusCount, "minCorpusCount", "maxCorpusCount") ||
+			!within(word.DictionaryCount, "minDictionaryCount", "maxDictionaryCount") {
+			continue
+		}
+
+		if len(include) > 0 && !hasPartOfSpeech(word, include) {
+			continue
+		}
+		if len(exclude) > 0 && hasPartOfSpeech(word, exclude) {
+			continue
+		}
+
+		words = append(words, word)
+	}
+
+	sort.Slice(words, func(i, j int) bool {
+		return words[i].Word < words[j].Word
+	})
+	return words
+}
+
+// hasPartOfSpeech reports whether any of the word's definitions is one of the
+// given parts of speech.
+func hasPartOfSpeech(word Word, partsOfSpeech []string) bool {
+	for _, def := range word.Definitions {
+		if contains(partsOfSpeech, def.PartOfSpeech) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordObjects converts words to the objects returned by the random word
+// endpoints.
+func wordObjects(words []Word) []map[string]interface{} {
+	objects := []map[string]interface{}{}
+	for _, word := range words {
+		canonical := word.CanonicalForm
+		if canonical == "" {
+			canonical = word.Word
+		}
+		objects = append(objects, map[string]interface{}{
+			"id":            0,
+			"word":          word.Word,
+			"canonicalForm": canonical,
+		})
+	}
+	return objects
+}
@@ -1,6 +1,7 @@
 package wordnik
 
 import (
+	"context"
 	"errors"
 	"net/url"
 )
@@ -42,10 +43,10 @@ type ContentProvider struct {
 
 // SimpleDefinition as defined by the Wordnik API.
 type SimpleDefinition struct {
-	Text         string `json:"text"`
-	Source       string `json:"source"`
-	Note         string `json:"note"`
-	PartOfSpeech string `json:"partOfSpeech"`
+	Text         string       `json:"text"`
+	Source       string       `json:"source"`
+	Note         string       `json:"note"`
+	PartOfSpeech PartOfSpeech `json:"partOfSpeech"`
 }
 
 // SimpleExample as defined by the Wordnik API.
@@ -64,22 +65,22 @@ type DefinitionSearchResults struct {
 
 // Definition as defined by the Wordnik API.
 type Definition struct {
-	ExtendedText     string         `json:"extendedText"`
-	Text             string         `json:"text"`
-	SourceDictionary string         `json:"sourceDictionary"`
-	Citations        []Citation     `json:"citations"`
-	Labels           []Label        `json:"labels"`
-	Score            float64        `json:"score"` //'NaN' will be zero-valued
-	ExampleUses      []ExampleUsage `json:"exampleUses"`
-	AttributionURL   string         `json:"attributionUrl"`
-	SeqString        string         `json:"seqString"`
-	AttributionText  string         `json:"attributionText"`
-	RelatedWords     []RelatedWord  `json:"relatedWords"`
-	Sequence         string         `json:"sequence"`
-	Word             string         `json:"word"`
-	Notes            []Note         `json:"notes"`
-	TextProns        []TextPron     `json:"textProns"`
-	PartOfSpeech     string         `json:"partOfSpeech"`
+	ExtendedText     string           `json:"extendedText"`
+	Text             string           `json:"text"`
+	SourceDictionary SourceDictionary `json:"sourceDictionary"`
+	Citations        []Citation       `json:"citations"`
+	Labels           []Label          `json:"labels"`
+	Score            float64          `json:"score"` //'NaN' will be zero-valued
+	ExampleUses      []ExampleUsage   `json:"exampleUses"`
+	AttributionURL   string           `json:"attributionUrl"`
+	SeqString        string           `json:"seqString"`
+	AttributionText  string           `json:"attributionText"`
+	RelatedWords     []RelatedWord    `json:"relatedWords"`
+	Sequence         string           `json:"sequence"`
+	Word             string           `json:"word"`
+	Notes            []Note           `json:"notes"`
+	TextProns        []TextPron       `json:"textProns"`
+	PartOfSpeech     PartOfSpeech     `json:"partOfSpeech"`
 }
 
 // Citation as defined by the Wordnik API.
@@ -101,13 +102,13 @@ type ExampleUsage struct {
 
 // RelatedWord as defined by the Wordnik API.
 type RelatedWord struct {
-	Label1           string   `json:"label1"`
-	RelationshipType string   `json:"relationshipType"`
-	Label2           string   `json:"label2"`
-	Label3           string   `json:"label3"`
-	Words            []string `json:"words"`
-	Gram             string   `json:"gram"`
-	Label4           string   `json:"label4"`
+	Label1           string           `json:"label1"`
+	RelationshipType RelationshipType `json:"relationshipType"`
+	Label2           string           `json:"label2"`
+	Label3           string           `json:"label3"`
+	Words            []string         `json:"words"`
+	Gram             string           `json:"gram"`
+	Label4           string           `json:"label4"`
 }
 
 // Note as defined by the Wordnik API.
@@ -120,26 +121,32 @@ type Note struct {
 
 // TextPron as defined by the Wordnik API.
 type TextPron struct {
-	Raw     string `json:"raw"`
-	Seq     int64  `json:"seq"`
-	RawType string `json:"rawType"`
+	Raw     string              `json:"raw"`
+	Seq     int64               `json:"seq"`
+	RawType PronunciationFormat `json:"rawType"`
 }
 
 // GetWordOfTheDay returns the word of the day for a given date string in the
 // format "yyyy-MM-dd".
 func (c *Client) GetWordOfTheDay(dateString string) (WordOfTheDay, error) {
+	return c.GetWordOfTheDayContext(context.Background(), dateString)
+}
+
+// GetWordOfTheDayContext is like GetWordOfTheDay, but carries a context for
+// cancellation and deadlines.
+func (c *Client) GetWordOfTheDayContext(ctx context.Context, dateString string) (WordOfTheDay, error) {
 	rel := &url.URL{Path: "words.json/wordOfTheDay"}
 
 	q := url.Values{}
 	q.Set("date", dateString)
 
-	req, err := c.formRequest(rel, q, "GET")
+	req, err := c.formRequest(ctx, rel, q, "GET")
 	if err != nil {
 		return WordOfTheDay{}, err
 	}
 
 	var wotd WordOfTheDay
-	err = c.doRequest(req, &wotd)
+	err = c.doRequest("GetWordOfTheDay", req, &wotd)
 	if err != nil {
 		return WordOfTheDay{}, err
 	}
@@ -151,6 +158,12 @@ func (c *Client) GetWordOfTheDay(dateString string) (WordOfTheDay, error) {
 // but other 'incorrect' parameters are left to the APIs discretion. Configured
 // with QueryOption functions, which ensure basic parameter vailidity.
 func (c *Client) SearchWords(query string, queryOptions ...QueryOption) (WordSearchResults, error) {
+	return c.SearchWordsContext(context.Background(), query, queryOptions...)
+}
+
+// SearchWordsContext is like SearchWords, but carries a context for
+// cancellation and deadlines.
+func (c *Client) SearchWordsContext(ctx context.Context, query string, queryOptions ...QueryOption) (WordSearchResults, error) {
 	if query == "" {
 		return WordSearchResults{}, errors.New("empty query string not allowed")
 	}
@@ -171,7 +184,7 @@ func (c *Client) SearchWords(query string, queryOptions ...QueryOption) (WordSea
 	}
 
 	var results WordSearchResults
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "SearchWords", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -182,6 +195,12 @@ func (c *Client) SearchWords(query string, queryOptions ...QueryOption) (WordSea
 // parameter vailidity. See Wordnik docs for appropriate parameters:
 // http://developer.wordnik.com/docs.html#!/words/reverseDictionary_get_2
 func (c *Client) ReverseDictionary(query string, queryOptions ...QueryOption) (DefinitionSearchResults, error) {
+	return c.ReverseDictionaryContext(context.Background(), query, queryOptions...)
+}
+
+// ReverseDictionaryContext is like ReverseDictionary, but carries a context for
+// cancellation and deadlines.
+func (c *Client) ReverseDictionaryContext(ctx context.Context, query string, queryOptions ...QueryOption) (DefinitionSearchResults, error) {
 	if query == "" {
 		return DefinitionSearchResults{}, errors.New("empty query string not allowed")
 	}
@@ -200,17 +219,17 @@ func (c *Client) ReverseDictionary(query string, queryOptions ...QueryOption) (D
 		"limit":          []string{"10"},
 	}
 
-	for _, option := range queryOptions {
-		option(&q)
+	if err := c.applyOptions("ReverseDictionary", &q, queryOptions); err != nil {
+		return DefinitionSearchResults{}, err
 	}
 
-	req, err := c.formRequest(rel, q, "GET")
+	req, err := c.formRequest(ctx, rel, q, "GET")
 	if err != nil {
 		return DefinitionSearchResults{}, err
 	}
 
 	var results DefinitionSearchResults
-	err = c.doRequest(req, &results)
+	err = c.doRequest("ReverseDictionary", req, &results)
 
 	if err != nil && err.Error() == "json: cannot unmarshal string into Go struct field Definition.score of type float64" {
 		// This error can be ignored, as it means that 'NaN' was present and will
@@ -226,6 +245,12 @@ func (c *Client) ReverseDictionary(query string, queryOptions ...QueryOption) (D
 // parameter vailidity. See Wordnik docs for appropriate parameters:
 // http://developer.wordnik.com/docs.html#!/words/getRandomWords_get_3
 func (c *Client) RandomWords(queryOptions ...QueryOption) ([]WordObject, error) {
+	return c.RandomWordsContext(context.Background(), queryOptions...)
+}
+
+// RandomWordsContext is like RandomWords, but carries a context for
+// cancellation and deadlines.
+func (c *Client) RandomWordsContext(ctx context.Context, queryOptions ...QueryOption) ([]WordObject, error) {
 	rel := &url.URL{Path: "words.json/randomWords"}
 
 	// Default values
@@ -241,7 +266,7 @@ func (c *Client) RandomWords(queryOptions ...QueryOption) ([]WordObject, error)
 	}
 
 	var results []WordObject
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "RandomWords", rel, q, &results, queryOptions...)
 
 	return results, err
 }
@@ -251,6 +276,12 @@ func (c *Client) RandomWords(queryOptions ...QueryOption) ([]WordObject, error)
 // vailidity. See Wordnik docs for appropriate parameters:
 // http://developer.wordnik.com/docs.html#!/words/getRandomWord_get_4
 func (c *Client) RandomWord(queryOptions ...QueryOption) (WordObject, error) {
+	return c.RandomWordContext(context.Background(), queryOptions...)
+}
+
+// RandomWordContext is like RandomWord, but carries a context for cancellation
+// and deadlines.
+func (c *Client) RandomWordContext(ctx context.Context, queryOptions ...QueryOption) (WordObject, error) {
 	rel := &url.URL{Path: "words.json/randomWord"}
 
 	// Default values
@@ -265,7 +296,7 @@ func (c *Client) RandomWord(queryOptions ...QueryOption) (WordObject, error) {
 	}
 
 	var results WordObject
-	err := c.basicGetRequest(rel, q, &results, queryOptions...)
+	err := c.basicGetRequest(ctx, "RandomWord", rel, q, &results, queryOptions...)
 
 	return results, err
 }